@@ -0,0 +1,10 @@
+// Package api содержит спецификацию OpenAPI сервиса, встроенную в бинарный файл,
+// и описание gRPC-интерфейса в proto/tender/v1
+package api
+
+import _ "embed"
+
+//go:generate protoc -I proto --go_out=proto --go_opt=paths=source_relative --go-grpc_out=proto --go-grpc_opt=paths=source_relative tender/v1/tender.proto
+
+//go:embed openapi.yml
+var Spec []byte
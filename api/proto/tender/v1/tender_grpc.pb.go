@@ -0,0 +1,2141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: tender/v1/tender.proto
+
+// gRPC-интерфейс сервиса тендеров. Операции повторяют REST-маршруты /api/tenders и /api/bids
+// и выполняются тем же сервисным слоем, поэтому проверки и тексты ошибок совпадают.
+// Код Go генерируется командой `go generate ./api` (нужны protoc, protoc-gen-go и protoc-gen-go-grpc).
+
+package tenderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenderService_CreateTender_FullMethodName           = "/tender.v1.TenderService/CreateTender"
+	TenderService_ListTenders_FullMethodName            = "/tender.v1.TenderService/ListTenders"
+	TenderService_ListUserTenders_FullMethodName        = "/tender.v1.TenderService/ListUserTenders"
+	TenderService_GetTenderStatus_FullMethodName        = "/tender.v1.TenderService/GetTenderStatus"
+	TenderService_UpdateTenderStatus_FullMethodName     = "/tender.v1.TenderService/UpdateTenderStatus"
+	TenderService_EditTender_FullMethodName             = "/tender.v1.TenderService/EditTender"
+	TenderService_RollbackTender_FullMethodName         = "/tender.v1.TenderService/RollbackTender"
+	TenderService_ListTenderLots_FullMethodName         = "/tender.v1.TenderService/ListTenderLots"
+	TenderService_AskClarification_FullMethodName       = "/tender.v1.TenderService/AskClarification"
+	TenderService_ListClarifications_FullMethodName     = "/tender.v1.TenderService/ListClarifications"
+	TenderService_AnswerClarification_FullMethodName    = "/tender.v1.TenderService/AnswerClarification"
+	TenderService_SetEvaluationCriteria_FullMethodName  = "/tender.v1.TenderService/SetEvaluationCriteria"
+	TenderService_ListEvaluationCriteria_FullMethodName = "/tender.v1.TenderService/ListEvaluationCriteria"
+	TenderService_GetBidRanking_FullMethodName          = "/tender.v1.TenderService/GetBidRanking"
+	TenderService_ListAuditEvents_FullMethodName        = "/tender.v1.TenderService/ListAuditEvents"
+	TenderService_GetAuction_FullMethodName             = "/tender.v1.TenderService/GetAuction"
+	TenderService_ListTenderAwards_FullMethodName       = "/tender.v1.TenderService/ListTenderAwards"
+	TenderService_GetAward_FullMethodName               = "/tender.v1.TenderService/GetAward"
+	TenderService_UpdateAwardStatus_FullMethodName      = "/tender.v1.TenderService/UpdateAwardStatus"
+	TenderService_AddAwardMilestone_FullMethodName      = "/tender.v1.TenderService/AddAwardMilestone"
+	TenderService_CompleteAwardMilestone_FullMethodName = "/tender.v1.TenderService/CompleteAwardMilestone"
+	TenderService_InviteToTender_FullMethodName         = "/tender.v1.TenderService/InviteToTender"
+	TenderService_ListTenderInvitations_FullMethodName  = "/tender.v1.TenderService/ListTenderInvitations"
+	TenderService_RevokeTenderInvitation_FullMethodName = "/tender.v1.TenderService/RevokeTenderInvitation"
+	TenderService_ListUserInvitations_FullMethodName    = "/tender.v1.TenderService/ListUserInvitations"
+	TenderService_RespondToInvitation_FullMethodName    = "/tender.v1.TenderService/RespondToInvitation"
+	TenderService_WatchStatusChanges_FullMethodName     = "/tender.v1.TenderService/WatchStatusChanges"
+)
+
+// TenderServiceClient is the client API for TenderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenderServiceClient interface {
+	CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	ListUserTenders(ctx context.Context, in *ListUserTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error)
+	UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error)
+	EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	ListTenderLots(ctx context.Context, in *ListTenderLotsRequest, opts ...grpc.CallOption) (*ListTenderLotsResponse, error)
+	AskClarification(ctx context.Context, in *AskClarificationRequest, opts ...grpc.CallOption) (*Clarification, error)
+	ListClarifications(ctx context.Context, in *ListClarificationsRequest, opts ...grpc.CallOption) (*ListClarificationsResponse, error)
+	AnswerClarification(ctx context.Context, in *AnswerClarificationRequest, opts ...grpc.CallOption) (*Clarification, error)
+	SetEvaluationCriteria(ctx context.Context, in *SetEvaluationCriteriaRequest, opts ...grpc.CallOption) (*ListEvaluationCriteriaResponse, error)
+	ListEvaluationCriteria(ctx context.Context, in *ListEvaluationCriteriaRequest, opts ...grpc.CallOption) (*ListEvaluationCriteriaResponse, error)
+	GetBidRanking(ctx context.Context, in *GetBidRankingRequest, opts ...grpc.CallOption) (*GetBidRankingResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	GetAuction(ctx context.Context, in *GetAuctionRequest, opts ...grpc.CallOption) (*Auction, error)
+	ListTenderAwards(ctx context.Context, in *ListTenderAwardsRequest, opts ...grpc.CallOption) (*ListTenderAwardsResponse, error)
+	GetAward(ctx context.Context, in *GetAwardRequest, opts ...grpc.CallOption) (*Award, error)
+	UpdateAwardStatus(ctx context.Context, in *UpdateAwardStatusRequest, opts ...grpc.CallOption) (*Award, error)
+	AddAwardMilestone(ctx context.Context, in *AddAwardMilestoneRequest, opts ...grpc.CallOption) (*Award, error)
+	CompleteAwardMilestone(ctx context.Context, in *CompleteAwardMilestoneRequest, opts ...grpc.CallOption) (*Award, error)
+	InviteToTender(ctx context.Context, in *InviteToTenderRequest, opts ...grpc.CallOption) (*TenderInvitation, error)
+	ListTenderInvitations(ctx context.Context, in *ListTenderInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error)
+	RevokeTenderInvitation(ctx context.Context, in *RevokeTenderInvitationRequest, opts ...grpc.CallOption) (*RevokeTenderInvitationResponse, error)
+	ListUserInvitations(ctx context.Context, in *ListUserInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error)
+	RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error)
+	// WatchStatusChanges передаёт изменения статусов тендеров и предложений по мере их появления.
+	// Изменения тендеров видны по тем же правилам, что и тендер в REST: неопубликованные — только
+	// ответственным, по приглашениям — ещё и приглашённым, не отклонившим приглашение.
+	// Изменения предложений видны автору и ответственным за организацию тендера.
+	// Если клиент не успевает читать, поток завершается с кодом RESOURCE_EXHAUSTED.
+	WatchStatusChanges(ctx context.Context, in *WatchStatusChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusChange], error)
+}
+
+type tenderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenderServiceClient(cc grpc.ClientConnInterface) TenderServiceClient {
+	return &tenderServiceClient{cc}
+}
+
+func (c *tenderServiceClient) CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_CreateTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListUserTenders(ctx context.Context, in *ListUserTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListUserTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenderStatusResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_UpdateTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_EditTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_RollbackTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderLots(ctx context.Context, in *ListTenderLotsRequest, opts ...grpc.CallOption) (*ListTenderLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderLotsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) AskClarification(ctx context.Context, in *AskClarificationRequest, opts ...grpc.CallOption) (*Clarification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Clarification)
+	err := c.cc.Invoke(ctx, TenderService_AskClarification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListClarifications(ctx context.Context, in *ListClarificationsRequest, opts ...grpc.CallOption) (*ListClarificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClarificationsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListClarifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) AnswerClarification(ctx context.Context, in *AnswerClarificationRequest, opts ...grpc.CallOption) (*Clarification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Clarification)
+	err := c.cc.Invoke(ctx, TenderService_AnswerClarification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) SetEvaluationCriteria(ctx context.Context, in *SetEvaluationCriteriaRequest, opts ...grpc.CallOption) (*ListEvaluationCriteriaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEvaluationCriteriaResponse)
+	err := c.cc.Invoke(ctx, TenderService_SetEvaluationCriteria_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListEvaluationCriteria(ctx context.Context, in *ListEvaluationCriteriaRequest, opts ...grpc.CallOption) (*ListEvaluationCriteriaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEvaluationCriteriaResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListEvaluationCriteria_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetBidRanking(ctx context.Context, in *GetBidRankingRequest, opts ...grpc.CallOption) (*GetBidRankingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidRankingResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetBidRanking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetAuction(ctx context.Context, in *GetAuctionRequest, opts ...grpc.CallOption) (*Auction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Auction)
+	err := c.cc.Invoke(ctx, TenderService_GetAuction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderAwards(ctx context.Context, in *ListTenderAwardsRequest, opts ...grpc.CallOption) (*ListTenderAwardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderAwardsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderAwards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetAward(ctx context.Context, in *GetAwardRequest, opts ...grpc.CallOption) (*Award, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Award)
+	err := c.cc.Invoke(ctx, TenderService_GetAward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateAwardStatus(ctx context.Context, in *UpdateAwardStatusRequest, opts ...grpc.CallOption) (*Award, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Award)
+	err := c.cc.Invoke(ctx, TenderService_UpdateAwardStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) AddAwardMilestone(ctx context.Context, in *AddAwardMilestoneRequest, opts ...grpc.CallOption) (*Award, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Award)
+	err := c.cc.Invoke(ctx, TenderService_AddAwardMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CompleteAwardMilestone(ctx context.Context, in *CompleteAwardMilestoneRequest, opts ...grpc.CallOption) (*Award, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Award)
+	err := c.cc.Invoke(ctx, TenderService_CompleteAwardMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) InviteToTender(ctx context.Context, in *InviteToTenderRequest, opts ...grpc.CallOption) (*TenderInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderInvitation)
+	err := c.cc.Invoke(ctx, TenderService_InviteToTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderInvitations(ctx context.Context, in *ListTenderInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderInvitationsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RevokeTenderInvitation(ctx context.Context, in *RevokeTenderInvitationRequest, opts ...grpc.CallOption) (*RevokeTenderInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTenderInvitationResponse)
+	err := c.cc.Invoke(ctx, TenderService_RevokeTenderInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListUserInvitations(ctx context.Context, in *ListUserInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderInvitationsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListUserInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderInvitation)
+	err := c.cc.Invoke(ctx, TenderService_RespondToInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) WatchStatusChanges(ctx context.Context, in *WatchStatusChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TenderService_ServiceDesc.Streams[0], TenderService_WatchStatusChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStatusChangesRequest, StatusChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TenderService_WatchStatusChangesClient = grpc.ServerStreamingClient[StatusChange]
+
+// TenderServiceServer is the server API for TenderService service.
+// All implementations must embed UnimplementedTenderServiceServer
+// for forward compatibility.
+type TenderServiceServer interface {
+	CreateTender(context.Context, *CreateTenderRequest) (*Tender, error)
+	ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error)
+	ListUserTenders(context.Context, *ListUserTendersRequest) (*ListTendersResponse, error)
+	GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error)
+	UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error)
+	EditTender(context.Context, *EditTenderRequest) (*Tender, error)
+	RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error)
+	ListTenderLots(context.Context, *ListTenderLotsRequest) (*ListTenderLotsResponse, error)
+	AskClarification(context.Context, *AskClarificationRequest) (*Clarification, error)
+	ListClarifications(context.Context, *ListClarificationsRequest) (*ListClarificationsResponse, error)
+	AnswerClarification(context.Context, *AnswerClarificationRequest) (*Clarification, error)
+	SetEvaluationCriteria(context.Context, *SetEvaluationCriteriaRequest) (*ListEvaluationCriteriaResponse, error)
+	ListEvaluationCriteria(context.Context, *ListEvaluationCriteriaRequest) (*ListEvaluationCriteriaResponse, error)
+	GetBidRanking(context.Context, *GetBidRankingRequest) (*GetBidRankingResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	GetAuction(context.Context, *GetAuctionRequest) (*Auction, error)
+	ListTenderAwards(context.Context, *ListTenderAwardsRequest) (*ListTenderAwardsResponse, error)
+	GetAward(context.Context, *GetAwardRequest) (*Award, error)
+	UpdateAwardStatus(context.Context, *UpdateAwardStatusRequest) (*Award, error)
+	AddAwardMilestone(context.Context, *AddAwardMilestoneRequest) (*Award, error)
+	CompleteAwardMilestone(context.Context, *CompleteAwardMilestoneRequest) (*Award, error)
+	InviteToTender(context.Context, *InviteToTenderRequest) (*TenderInvitation, error)
+	ListTenderInvitations(context.Context, *ListTenderInvitationsRequest) (*ListTenderInvitationsResponse, error)
+	RevokeTenderInvitation(context.Context, *RevokeTenderInvitationRequest) (*RevokeTenderInvitationResponse, error)
+	ListUserInvitations(context.Context, *ListUserInvitationsRequest) (*ListTenderInvitationsResponse, error)
+	RespondToInvitation(context.Context, *RespondToInvitationRequest) (*TenderInvitation, error)
+	// WatchStatusChanges передаёт изменения статусов тендеров и предложений по мере их появления.
+	// Изменения тендеров видны по тем же правилам, что и тендер в REST: неопубликованные — только
+	// ответственным, по приглашениям — ещё и приглашённым, не отклонившим приглашение.
+	// Изменения предложений видны автору и ответственным за организацию тендера.
+	// Если клиент не успевает читать, поток завершается с кодом RESOURCE_EXHAUSTED.
+	WatchStatusChanges(*WatchStatusChangesRequest, grpc.ServerStreamingServer[StatusChange]) error
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+// UnimplementedTenderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenderServiceServer struct{}
+
+func (UnimplementedTenderServiceServer) CreateTender(context.Context, *CreateTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTender not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) ListUserTenders(context.Context, *ListUserTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) EditTender(context.Context, *EditTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTender not implemented")
+}
+func (UnimplementedTenderServiceServer) RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTender not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderLots(context.Context, *ListTenderLotsRequest) (*ListTenderLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderLots not implemented")
+}
+func (UnimplementedTenderServiceServer) AskClarification(context.Context, *AskClarificationRequest) (*Clarification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AskClarification not implemented")
+}
+func (UnimplementedTenderServiceServer) ListClarifications(context.Context, *ListClarificationsRequest) (*ListClarificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClarifications not implemented")
+}
+func (UnimplementedTenderServiceServer) AnswerClarification(context.Context, *AnswerClarificationRequest) (*Clarification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerClarification not implemented")
+}
+func (UnimplementedTenderServiceServer) SetEvaluationCriteria(context.Context, *SetEvaluationCriteriaRequest) (*ListEvaluationCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEvaluationCriteria not implemented")
+}
+func (UnimplementedTenderServiceServer) ListEvaluationCriteria(context.Context, *ListEvaluationCriteriaRequest) (*ListEvaluationCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvaluationCriteria not implemented")
+}
+func (UnimplementedTenderServiceServer) GetBidRanking(context.Context, *GetBidRankingRequest) (*GetBidRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidRanking not implemented")
+}
+func (UnimplementedTenderServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedTenderServiceServer) GetAuction(context.Context, *GetAuctionRequest) (*Auction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderAwards(context.Context, *ListTenderAwardsRequest) (*ListTenderAwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderAwards not implemented")
+}
+func (UnimplementedTenderServiceServer) GetAward(context.Context, *GetAwardRequest) (*Award, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAward not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateAwardStatus(context.Context, *UpdateAwardStatusRequest) (*Award, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAwardStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) AddAwardMilestone(context.Context, *AddAwardMilestoneRequest) (*Award, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAwardMilestone not implemented")
+}
+func (UnimplementedTenderServiceServer) CompleteAwardMilestone(context.Context, *CompleteAwardMilestoneRequest) (*Award, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAwardMilestone not implemented")
+}
+func (UnimplementedTenderServiceServer) InviteToTender(context.Context, *InviteToTenderRequest) (*TenderInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToTender not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderInvitations(context.Context, *ListTenderInvitationsRequest) (*ListTenderInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderInvitations not implemented")
+}
+func (UnimplementedTenderServiceServer) RevokeTenderInvitation(context.Context, *RevokeTenderInvitationRequest) (*RevokeTenderInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTenderInvitation not implemented")
+}
+func (UnimplementedTenderServiceServer) ListUserInvitations(context.Context, *ListUserInvitationsRequest) (*ListTenderInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserInvitations not implemented")
+}
+func (UnimplementedTenderServiceServer) RespondToInvitation(context.Context, *RespondToInvitationRequest) (*TenderInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToInvitation not implemented")
+}
+func (UnimplementedTenderServiceServer) WatchStatusChanges(*WatchStatusChangesRequest, grpc.ServerStreamingServer[StatusChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatusChanges not implemented")
+}
+func (UnimplementedTenderServiceServer) mustEmbedUnimplementedTenderServiceServer() {}
+func (UnimplementedTenderServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTenderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenderServiceServer will
+// result in compilation errors.
+type UnsafeTenderServiceServer interface {
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+func RegisterTenderServiceServer(s grpc.ServiceRegistrar, srv TenderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenderService_ServiceDesc, srv)
+}
+
+func _TenderService_CreateTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTender(ctx, req.(*CreateTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenders(ctx, req.(*ListTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListUserTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListUserTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListUserTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListUserTenders(ctx, req.(*ListUserTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, req.(*GetTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, req.(*UpdateTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_EditTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).EditTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_EditTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).EditTender(ctx, req.(*EditTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RollbackTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RollbackTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RollbackTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RollbackTender(ctx, req.(*RollbackTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderLots(ctx, req.(*ListTenderLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_AskClarification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskClarificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).AskClarification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_AskClarification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).AskClarification(ctx, req.(*AskClarificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListClarifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClarificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListClarifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListClarifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListClarifications(ctx, req.(*ListClarificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_AnswerClarification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerClarificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).AnswerClarification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_AnswerClarification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).AnswerClarification(ctx, req.(*AnswerClarificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_SetEvaluationCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEvaluationCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).SetEvaluationCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_SetEvaluationCriteria_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).SetEvaluationCriteria(ctx, req.(*SetEvaluationCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListEvaluationCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvaluationCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListEvaluationCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListEvaluationCriteria_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListEvaluationCriteria(ctx, req.(*ListEvaluationCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetBidRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetBidRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetBidRanking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetBidRanking(ctx, req.(*GetBidRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetAuction(ctx, req.(*GetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderAwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderAwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderAwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderAwards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderAwards(ctx, req.(*ListTenderAwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetAward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetAward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetAward(ctx, req.(*GetAwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateAwardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAwardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateAwardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateAwardStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateAwardStatus(ctx, req.(*UpdateAwardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_AddAwardMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAwardMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).AddAwardMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_AddAwardMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).AddAwardMilestone(ctx, req.(*AddAwardMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CompleteAwardMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAwardMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CompleteAwardMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CompleteAwardMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CompleteAwardMilestone(ctx, req.(*CompleteAwardMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_InviteToTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).InviteToTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_InviteToTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).InviteToTender(ctx, req.(*InviteToTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderInvitations(ctx, req.(*ListTenderInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RevokeTenderInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTenderInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RevokeTenderInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RevokeTenderInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RevokeTenderInvitation(ctx, req.(*RevokeTenderInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListUserInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListUserInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListUserInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListUserInvitations(ctx, req.(*ListUserInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RespondToInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RespondToInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RespondToInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RespondToInvitation(ctx, req.(*RespondToInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_WatchStatusChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TenderServiceServer).WatchStatusChanges(m, &grpc.GenericServerStream[WatchStatusChangesRequest, StatusChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TenderService_WatchStatusChangesServer = grpc.ServerStreamingServer[StatusChange]
+
+// TenderService_ServiceDesc is the grpc.ServiceDesc for TenderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tender.v1.TenderService",
+	HandlerType: (*TenderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTender",
+			Handler:    _TenderService_CreateTender_Handler,
+		},
+		{
+			MethodName: "ListTenders",
+			Handler:    _TenderService_ListTenders_Handler,
+		},
+		{
+			MethodName: "ListUserTenders",
+			Handler:    _TenderService_ListUserTenders_Handler,
+		},
+		{
+			MethodName: "GetTenderStatus",
+			Handler:    _TenderService_GetTenderStatus_Handler,
+		},
+		{
+			MethodName: "UpdateTenderStatus",
+			Handler:    _TenderService_UpdateTenderStatus_Handler,
+		},
+		{
+			MethodName: "EditTender",
+			Handler:    _TenderService_EditTender_Handler,
+		},
+		{
+			MethodName: "RollbackTender",
+			Handler:    _TenderService_RollbackTender_Handler,
+		},
+		{
+			MethodName: "ListTenderLots",
+			Handler:    _TenderService_ListTenderLots_Handler,
+		},
+		{
+			MethodName: "AskClarification",
+			Handler:    _TenderService_AskClarification_Handler,
+		},
+		{
+			MethodName: "ListClarifications",
+			Handler:    _TenderService_ListClarifications_Handler,
+		},
+		{
+			MethodName: "AnswerClarification",
+			Handler:    _TenderService_AnswerClarification_Handler,
+		},
+		{
+			MethodName: "SetEvaluationCriteria",
+			Handler:    _TenderService_SetEvaluationCriteria_Handler,
+		},
+		{
+			MethodName: "ListEvaluationCriteria",
+			Handler:    _TenderService_ListEvaluationCriteria_Handler,
+		},
+		{
+			MethodName: "GetBidRanking",
+			Handler:    _TenderService_GetBidRanking_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _TenderService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetAuction",
+			Handler:    _TenderService_GetAuction_Handler,
+		},
+		{
+			MethodName: "ListTenderAwards",
+			Handler:    _TenderService_ListTenderAwards_Handler,
+		},
+		{
+			MethodName: "GetAward",
+			Handler:    _TenderService_GetAward_Handler,
+		},
+		{
+			MethodName: "UpdateAwardStatus",
+			Handler:    _TenderService_UpdateAwardStatus_Handler,
+		},
+		{
+			MethodName: "AddAwardMilestone",
+			Handler:    _TenderService_AddAwardMilestone_Handler,
+		},
+		{
+			MethodName: "CompleteAwardMilestone",
+			Handler:    _TenderService_CompleteAwardMilestone_Handler,
+		},
+		{
+			MethodName: "InviteToTender",
+			Handler:    _TenderService_InviteToTender_Handler,
+		},
+		{
+			MethodName: "ListTenderInvitations",
+			Handler:    _TenderService_ListTenderInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeTenderInvitation",
+			Handler:    _TenderService_RevokeTenderInvitation_Handler,
+		},
+		{
+			MethodName: "ListUserInvitations",
+			Handler:    _TenderService_ListUserInvitations_Handler,
+		},
+		{
+			MethodName: "RespondToInvitation",
+			Handler:    _TenderService_RespondToInvitation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatusChanges",
+			Handler:       _TenderService_WatchStatusChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tender/v1/tender.proto",
+}
+
+const (
+	BidService_CreateBid_FullMethodName           = "/tender.v1.BidService/CreateBid"
+	BidService_ListUserBids_FullMethodName        = "/tender.v1.BidService/ListUserBids"
+	BidService_ListTenderBids_FullMethodName      = "/tender.v1.BidService/ListTenderBids"
+	BidService_GetBidStatus_FullMethodName        = "/tender.v1.BidService/GetBidStatus"
+	BidService_UpdateBidStatus_FullMethodName     = "/tender.v1.BidService/UpdateBidStatus"
+	BidService_SubmitBidDecision_FullMethodName   = "/tender.v1.BidService/SubmitBidDecision"
+	BidService_EditBid_FullMethodName             = "/tender.v1.BidService/EditBid"
+	BidService_RollbackBid_FullMethodName         = "/tender.v1.BidService/RollbackBid"
+	BidService_SubmitBidFeedback_FullMethodName   = "/tender.v1.BidService/SubmitBidFeedback"
+	BidService_ListBidReviews_FullMethodName      = "/tender.v1.BidService/ListBidReviews"
+	BidService_GetReputation_FullMethodName       = "/tender.v1.BidService/GetReputation"
+	BidService_SubmitBidScores_FullMethodName     = "/tender.v1.BidService/SubmitBidScores"
+	BidService_SubmitAuctionPrice_FullMethodName  = "/tender.v1.BidService/SubmitAuctionPrice"
+	BidService_GetAuctionPosition_FullMethodName  = "/tender.v1.BidService/GetAuctionPosition"
+	BidService_WithdrawBid_FullMethodName         = "/tender.v1.BidService/WithdrawBid"
+	BidService_ResubmitBid_FullMethodName         = "/tender.v1.BidService/ResubmitBid"
+	BidService_ListBidWithdrawals_FullMethodName  = "/tender.v1.BidService/ListBidWithdrawals"
+	BidService_RevokeBidDecision_FullMethodName   = "/tender.v1.BidService/RevokeBidDecision"
+	BidService_ListBidDecisions_FullMethodName    = "/tender.v1.BidService/ListBidDecisions"
+	BidService_ListBidFeedback_FullMethodName     = "/tender.v1.BidService/ListBidFeedback"
+	BidService_ReplyToFeedback_FullMethodName     = "/tender.v1.BidService/ReplyToFeedback"
+	BidService_ReportFeedback_FullMethodName      = "/tender.v1.BidService/ReportFeedback"
+	BidService_HideFeedback_FullMethodName        = "/tender.v1.BidService/HideFeedback"
+	BidService_RestoreFeedback_FullMethodName     = "/tender.v1.BidService/RestoreFeedback"
+	BidService_ListModerationQueue_FullMethodName = "/tender.v1.BidService/ListModerationQueue"
+)
+
+// BidServiceClient is the client API for BidService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BidServiceClient interface {
+	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListUserBids(ctx context.Context, in *ListUserBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error)
+	UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error)
+	SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error)
+	EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	SubmitBidFeedback(ctx context.Context, in *SubmitBidFeedbackRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+	GetReputation(ctx context.Context, in *GetReputationRequest, opts ...grpc.CallOption) (*Reputation, error)
+	SubmitBidScores(ctx context.Context, in *SubmitBidScoresRequest, opts ...grpc.CallOption) (*BidEvaluation, error)
+	SubmitAuctionPrice(ctx context.Context, in *SubmitAuctionPriceRequest, opts ...grpc.CallOption) (*AuctionPosition, error)
+	GetAuctionPosition(ctx context.Context, in *GetAuctionPositionRequest, opts ...grpc.CallOption) (*AuctionPosition, error)
+	WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ResubmitBid(ctx context.Context, in *ResubmitBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListBidWithdrawals(ctx context.Context, in *ListBidWithdrawalsRequest, opts ...grpc.CallOption) (*ListBidWithdrawalsResponse, error)
+	RevokeBidDecision(ctx context.Context, in *RevokeBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListBidDecisions(ctx context.Context, in *ListBidDecisionsRequest, opts ...grpc.CallOption) (*ListBidDecisionsResponse, error)
+	ListBidFeedback(ctx context.Context, in *ListBidFeedbackRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+	ReplyToFeedback(ctx context.Context, in *ReplyToFeedbackRequest, opts ...grpc.CallOption) (*FeedbackReply, error)
+	ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*FeedbackReport, error)
+	HideFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerationItem, error)
+	RestoreFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerationItem, error)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+}
+
+type bidServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBidServiceClient(cc grpc.ClientConnInterface) BidServiceClient {
+	return &bidServiceClient{cc}
+}
+
+func (c *bidServiceClient) CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_CreateBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListUserBids(ctx context.Context, in *ListUserBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListUserBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListTenderBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidStatusResponse)
+	err := c.cc.Invoke(ctx, BidService_GetBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_UpdateBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_SubmitBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_EditBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_RollbackBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitBidFeedback(ctx context.Context, in *SubmitBidFeedbackRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_SubmitBidFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListBidReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetReputation(ctx context.Context, in *GetReputationRequest, opts ...grpc.CallOption) (*Reputation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reputation)
+	err := c.cc.Invoke(ctx, BidService_GetReputation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitBidScores(ctx context.Context, in *SubmitBidScoresRequest, opts ...grpc.CallOption) (*BidEvaluation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BidEvaluation)
+	err := c.cc.Invoke(ctx, BidService_SubmitBidScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitAuctionPrice(ctx context.Context, in *SubmitAuctionPriceRequest, opts ...grpc.CallOption) (*AuctionPosition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuctionPosition)
+	err := c.cc.Invoke(ctx, BidService_SubmitAuctionPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetAuctionPosition(ctx context.Context, in *GetAuctionPositionRequest, opts ...grpc.CallOption) (*AuctionPosition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuctionPosition)
+	err := c.cc.Invoke(ctx, BidService_GetAuctionPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_WithdrawBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ResubmitBid(ctx context.Context, in *ResubmitBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_ResubmitBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListBidWithdrawals(ctx context.Context, in *ListBidWithdrawalsRequest, opts ...grpc.CallOption) (*ListBidWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListBidWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RevokeBidDecision(ctx context.Context, in *RevokeBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_RevokeBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListBidDecisions(ctx context.Context, in *ListBidDecisionsRequest, opts ...grpc.CallOption) (*ListBidDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidDecisionsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListBidDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListBidFeedback(ctx context.Context, in *ListBidFeedbackRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListBidFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ReplyToFeedback(ctx context.Context, in *ReplyToFeedbackRequest, opts ...grpc.CallOption) (*FeedbackReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedbackReply)
+	err := c.cc.Invoke(ctx, BidService_ReplyToFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*FeedbackReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedbackReport)
+	err := c.cc.Invoke(ctx, BidService_ReportFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) HideFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerationItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationItem)
+	err := c.cc.Invoke(ctx, BidService_HideFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RestoreFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerationItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationItem)
+	err := c.cc.Invoke(ctx, BidService_RestoreFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, BidService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BidServiceServer is the server API for BidService service.
+// All implementations must embed UnimplementedBidServiceServer
+// for forward compatibility.
+type BidServiceServer interface {
+	CreateBid(context.Context, *CreateBidRequest) (*Bid, error)
+	ListUserBids(context.Context, *ListUserBidsRequest) (*ListBidsResponse, error)
+	ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error)
+	GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error)
+	UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error)
+	SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error)
+	EditBid(context.Context, *EditBidRequest) (*Bid, error)
+	RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error)
+	SubmitBidFeedback(context.Context, *SubmitBidFeedbackRequest) (*Bid, error)
+	ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error)
+	GetReputation(context.Context, *GetReputationRequest) (*Reputation, error)
+	SubmitBidScores(context.Context, *SubmitBidScoresRequest) (*BidEvaluation, error)
+	SubmitAuctionPrice(context.Context, *SubmitAuctionPriceRequest) (*AuctionPosition, error)
+	GetAuctionPosition(context.Context, *GetAuctionPositionRequest) (*AuctionPosition, error)
+	WithdrawBid(context.Context, *WithdrawBidRequest) (*Bid, error)
+	ResubmitBid(context.Context, *ResubmitBidRequest) (*Bid, error)
+	ListBidWithdrawals(context.Context, *ListBidWithdrawalsRequest) (*ListBidWithdrawalsResponse, error)
+	RevokeBidDecision(context.Context, *RevokeBidDecisionRequest) (*Bid, error)
+	ListBidDecisions(context.Context, *ListBidDecisionsRequest) (*ListBidDecisionsResponse, error)
+	ListBidFeedback(context.Context, *ListBidFeedbackRequest) (*ListBidReviewsResponse, error)
+	ReplyToFeedback(context.Context, *ReplyToFeedbackRequest) (*FeedbackReply, error)
+	ReportFeedback(context.Context, *ReportFeedbackRequest) (*FeedbackReport, error)
+	HideFeedback(context.Context, *ModerateFeedbackRequest) (*ModerationItem, error)
+	RestoreFeedback(context.Context, *ModerateFeedbackRequest) (*ModerationItem, error)
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+// UnimplementedBidServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBidServiceServer struct{}
+
+func (UnimplementedBidServiceServer) CreateBid(context.Context, *CreateBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+}
+func (UnimplementedBidServiceServer) ListUserBids(context.Context, *ListUserBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserBids not implemented")
+}
+func (UnimplementedBidServiceServer) ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderBids not implemented")
+}
+func (UnimplementedBidServiceServer) GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) EditBid(context.Context, *EditBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditBid not implemented")
+}
+func (UnimplementedBidServiceServer) RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackBid not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitBidFeedback(context.Context, *SubmitBidFeedbackRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidReviews not implemented")
+}
+func (UnimplementedBidServiceServer) GetReputation(context.Context, *GetReputationRequest) (*Reputation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputation not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitBidScores(context.Context, *SubmitBidScoresRequest) (*BidEvaluation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidScores not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitAuctionPrice(context.Context, *SubmitAuctionPriceRequest) (*AuctionPosition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAuctionPrice not implemented")
+}
+func (UnimplementedBidServiceServer) GetAuctionPosition(context.Context, *GetAuctionPositionRequest) (*AuctionPosition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuctionPosition not implemented")
+}
+func (UnimplementedBidServiceServer) WithdrawBid(context.Context, *WithdrawBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBid not implemented")
+}
+func (UnimplementedBidServiceServer) ResubmitBid(context.Context, *ResubmitBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitBid not implemented")
+}
+func (UnimplementedBidServiceServer) ListBidWithdrawals(context.Context, *ListBidWithdrawalsRequest) (*ListBidWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidWithdrawals not implemented")
+}
+func (UnimplementedBidServiceServer) RevokeBidDecision(context.Context, *RevokeBidDecisionRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) ListBidDecisions(context.Context, *ListBidDecisionsRequest) (*ListBidDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidDecisions not implemented")
+}
+func (UnimplementedBidServiceServer) ListBidFeedback(context.Context, *ListBidFeedbackRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) ReplyToFeedback(context.Context, *ReplyToFeedbackRequest) (*FeedbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) ReportFeedback(context.Context, *ReportFeedbackRequest) (*FeedbackReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) HideFeedback(context.Context, *ModerateFeedbackRequest) (*ModerationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) RestoreFeedback(context.Context, *ModerateFeedbackRequest) (*ModerationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedBidServiceServer) mustEmbedUnimplementedBidServiceServer() {}
+func (UnimplementedBidServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeBidServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BidServiceServer will
+// result in compilation errors.
+type UnsafeBidServiceServer interface {
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+func RegisterBidServiceServer(s grpc.ServiceRegistrar, srv BidServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBidServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BidService_ServiceDesc, srv)
+}
+
+func _BidService_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CreateBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CreateBid(ctx, req.(*CreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListUserBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListUserBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListUserBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListUserBids(ctx, req.(*ListUserBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListTenderBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListTenderBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListTenderBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListTenderBids(ctx, req.(*ListTenderBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetBidStatus(ctx, req.(*GetBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_UpdateBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_UpdateBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, req.(*UpdateBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, req.(*SubmitBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_EditBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).EditBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_EditBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).EditBid(ctx, req.(*EditBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RollbackBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RollbackBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RollbackBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RollbackBid(ctx, req.(*RollbackBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitBidFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitBidFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitBidFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitBidFeedback(ctx, req.(*SubmitBidFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListBidReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListBidReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListBidReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListBidReviews(ctx, req.(*ListBidReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetReputation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetReputation(ctx, req.(*GetReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitBidScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitBidScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitBidScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitBidScores(ctx, req.(*SubmitBidScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitAuctionPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAuctionPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitAuctionPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitAuctionPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitAuctionPrice(ctx, req.(*SubmitAuctionPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetAuctionPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetAuctionPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetAuctionPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetAuctionPosition(ctx, req.(*GetAuctionPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_WithdrawBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).WithdrawBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_WithdrawBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).WithdrawBid(ctx, req.(*WithdrawBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ResubmitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ResubmitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ResubmitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ResubmitBid(ctx, req.(*ResubmitBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListBidWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListBidWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListBidWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListBidWithdrawals(ctx, req.(*ListBidWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RevokeBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RevokeBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RevokeBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RevokeBidDecision(ctx, req.(*RevokeBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListBidDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListBidDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListBidDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListBidDecisions(ctx, req.(*ListBidDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListBidFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListBidFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListBidFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListBidFeedback(ctx, req.(*ListBidFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ReplyToFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ReplyToFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ReplyToFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ReplyToFeedback(ctx, req.(*ReplyToFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ReportFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ReportFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ReportFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ReportFeedback(ctx, req.(*ReportFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_HideFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).HideFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_HideFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).HideFeedback(ctx, req.(*ModerateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RestoreFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RestoreFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RestoreFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RestoreFeedback(ctx, req.(*ModerateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BidService_ServiceDesc is the grpc.ServiceDesc for BidService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BidService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tender.v1.BidService",
+	HandlerType: (*BidServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBid",
+			Handler:    _BidService_CreateBid_Handler,
+		},
+		{
+			MethodName: "ListUserBids",
+			Handler:    _BidService_ListUserBids_Handler,
+		},
+		{
+			MethodName: "ListTenderBids",
+			Handler:    _BidService_ListTenderBids_Handler,
+		},
+		{
+			MethodName: "GetBidStatus",
+			Handler:    _BidService_GetBidStatus_Handler,
+		},
+		{
+			MethodName: "UpdateBidStatus",
+			Handler:    _BidService_UpdateBidStatus_Handler,
+		},
+		{
+			MethodName: "SubmitBidDecision",
+			Handler:    _BidService_SubmitBidDecision_Handler,
+		},
+		{
+			MethodName: "EditBid",
+			Handler:    _BidService_EditBid_Handler,
+		},
+		{
+			MethodName: "RollbackBid",
+			Handler:    _BidService_RollbackBid_Handler,
+		},
+		{
+			MethodName: "SubmitBidFeedback",
+			Handler:    _BidService_SubmitBidFeedback_Handler,
+		},
+		{
+			MethodName: "ListBidReviews",
+			Handler:    _BidService_ListBidReviews_Handler,
+		},
+		{
+			MethodName: "GetReputation",
+			Handler:    _BidService_GetReputation_Handler,
+		},
+		{
+			MethodName: "SubmitBidScores",
+			Handler:    _BidService_SubmitBidScores_Handler,
+		},
+		{
+			MethodName: "SubmitAuctionPrice",
+			Handler:    _BidService_SubmitAuctionPrice_Handler,
+		},
+		{
+			MethodName: "GetAuctionPosition",
+			Handler:    _BidService_GetAuctionPosition_Handler,
+		},
+		{
+			MethodName: "WithdrawBid",
+			Handler:    _BidService_WithdrawBid_Handler,
+		},
+		{
+			MethodName: "ResubmitBid",
+			Handler:    _BidService_ResubmitBid_Handler,
+		},
+		{
+			MethodName: "ListBidWithdrawals",
+			Handler:    _BidService_ListBidWithdrawals_Handler,
+		},
+		{
+			MethodName: "RevokeBidDecision",
+			Handler:    _BidService_RevokeBidDecision_Handler,
+		},
+		{
+			MethodName: "ListBidDecisions",
+			Handler:    _BidService_ListBidDecisions_Handler,
+		},
+		{
+			MethodName: "ListBidFeedback",
+			Handler:    _BidService_ListBidFeedback_Handler,
+		},
+		{
+			MethodName: "ReplyToFeedback",
+			Handler:    _BidService_ReplyToFeedback_Handler,
+		},
+		{
+			MethodName: "ReportFeedback",
+			Handler:    _BidService_ReportFeedback_Handler,
+		},
+		{
+			MethodName: "HideFeedback",
+			Handler:    _BidService_HideFeedback_Handler,
+		},
+		{
+			MethodName: "RestoreFeedback",
+			Handler:    _BidService_RestoreFeedback_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _BidService_ListModerationQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tender/v1/tender.proto",
+}
@@ -0,0 +1,429 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"tender-service/config"
+	"tender-service/internal/database"
+	"tender-service/internal/health"
+	"tender-service/internal/idempotency"
+	"tender-service/internal/openapi"
+	"tender-service/internal/service"
+	"tender-service/internal/storage"
+)
+
+// testPostgresEnv — строка подключения к отдельной базе для контрактного теста.
+// Тест создаёт в ней пользователей и организации, поэтому рабочая база из POSTGRES_CONN не используется.
+const testPostgresEnv = "TEST_POSTGRES_CONN"
+
+// contractSchema создаёт таблицы пользователей и организаций из задания: сервис их только читает
+const contractSchema = `
+	CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+	DO $$ BEGIN
+		CREATE TYPE organization_type AS ENUM ('IE', 'LLC', 'JSC');
+	EXCEPTION WHEN duplicate_object THEN NULL;
+	END $$;
+	CREATE TABLE IF NOT EXISTS employee (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		username VARCHAR(50) UNIQUE NOT NULL,
+		first_name VARCHAR(50),
+		last_name VARCHAR(50),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE TABLE IF NOT EXISTS organization (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		name VARCHAR(100) NOT NULL,
+		description TEXT,
+		type organization_type,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE TABLE IF NOT EXISTS organization_responsible (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+		user_id UUID REFERENCES employee(id) ON DELETE CASCADE
+	);
+`
+
+// TestRoutesMatchOpenAPI проходит по всем маршрутам роутера с включённой проверкой ответов:
+// ответ, не совпадающий со схемой api/openapi.yml, превращается в 500, и тест падает
+// на неожиданном статусе. В конце тест проверяет, что не пропущен ни один маршрут.
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	dsn := os.Getenv(testPostgresEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set, skipping contract test", testPostgresEnv)
+	}
+
+	ctx := context.Background()
+	pool, err := database.ConnectPostgres(ctx, config.PostgresConfig{Conn: dsn})
+	if err != nil {
+		t.Fatalf("connect to postgres: %v", err)
+	}
+	defer database.Close()
+	if _, err := pool.Exec(ctx, contractSchema); err != nil {
+		t.Fatalf("create employee and organization tables: %v", err)
+	}
+	if err := database.CreateTables(ctx); err != nil {
+		t.Fatalf("create tables: %v", err)
+	}
+	health.Register("database", database.Ping)
+	health.Register("migrations", database.CheckSchema)
+
+	attachments, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("create attachment storage: %v", err)
+	}
+	service.SetupAttachments(attachments, 1<<20)
+
+	validator, err := openapi.New(true, true)
+	if err != nil {
+		t.Fatalf("load openapi spec: %v", err)
+	}
+	c := &contract{
+		t:       t,
+		router:  setupRoutes(validator, idempotency.New(time.Hour, time.Minute)),
+		covered: map[string]bool{},
+	}
+	f := seedFixtures(t, pool)
+
+	c.do(http.MethodGet, "/api/ping", nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/openapi.yml", nil, http.StatusOK)
+	c.do(http.MethodGet, "/healthz", nil, http.StatusOK)
+	c.do(http.MethodGet, "/readyz", nil, http.StatusOK)
+	c.do(http.MethodGet, "/metrics", nil, http.StatusOK)
+
+	// Тендер по приглашениям: версии, публикация, файлы, критерии, приглашения и вопросы
+	tenderID := idOf(t, c.idempotent(http.MethodPost, "/api/tenders/new", map[string]interface{}{
+		"name":            "Поставка ноутбуков",
+		"description":     "Сто ноутбуков для офиса",
+		"serviceType":     "Delivery",
+		"organizationId":  f.customer,
+		"creatorUsername": f.owners[0],
+		"visibility":      "InviteOnly",
+	}, http.StatusOK))
+	tender := "/api/tenders/" + tenderID
+	c.do(http.MethodGet, "/api/tenders?limit=5", nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/tenders/my?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodGet, tender+"/status?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodPatch, tender+"/edit?username="+f.owners[0], map[string]string{"description": "Двести ноутбуков для офиса"}, http.StatusOK)
+	c.do(http.MethodPut, tender+"/rollback/1?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodPut, tender+"/status?status=Published&username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodGet, tender+"/lots?username="+f.owners[0], nil, http.StatusOK)
+	c.attachments(tender, f.owners[0])
+
+	criteria := c.do(http.MethodPut, tender+"/criteria?username="+f.owners[0], []map[string]interface{}{
+		{"name": "Цена", "weight": 60},
+		{"name": "Срок поставки", "weight": 40},
+	}, http.StatusOK)
+	c.do(http.MethodGet, tender+"/criteria?username="+f.owners[0], nil, http.StatusOK)
+
+	invitation := idOf(t, c.do(http.MethodPost, tender+"/invitations?username="+f.owners[0], map[string]string{"username": f.supplier}, http.StatusOK))
+	declined := idOf(t, c.do(http.MethodPost, tender+"/invitations?username="+f.owners[0], map[string]string{"username": f.guest}, http.StatusOK))
+	revoked := idOf(t, c.do(http.MethodPost, tender+"/invitations?username="+f.owners[0], map[string]string{"username": f.outsider}, http.StatusOK))
+	c.do(http.MethodDelete, tender+"/invitations/"+revoked+"?username="+f.owners[0], nil, http.StatusNoContent)
+	c.do(http.MethodGet, tender+"/invitations?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/invitations/my?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodPut, "/api/invitations/"+invitation+"/accept?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodPut, "/api/invitations/"+declined+"/decline?username="+f.guest, nil, http.StatusOK)
+
+	clarification := idOf(t, c.do(http.MethodPost, tender+"/clarifications?username="+f.supplier, map[string]string{"question": "Допускается ли поставка двумя партиями?"}, http.StatusOK))
+	c.do(http.MethodPut, tender+"/clarifications/"+clarification+"/answer?username="+f.owners[0], map[string]string{"answer": "Да"}, http.StatusOK)
+	c.do(http.MethodGet, tender+"/clarifications?username="+f.supplier, nil, http.StatusOK)
+
+	// Предложение: версии, публикация, файлы, отзыв и повторная подача, оценки
+	bidID := idOf(t, c.idempotent(http.MethodPost, "/api/bids/new", map[string]string{
+		"name":        "Ноутбуки со склада",
+		"description": "Поставка за две недели",
+		"tenderId":    tenderID,
+		"authorType":  "User",
+		"authorId":    f.supplierID,
+	}, http.StatusOK))
+	bid := "/api/bids/" + bidID
+	c.do(http.MethodGet, bid+"/status?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodPatch, bid+"/edit?username="+f.supplier, map[string]string{"description": "Поставка за неделю"}, http.StatusOK)
+	c.do(http.MethodPut, bid+"/rollback/1?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodPut, bid+"/status?status=Published&username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/bids/my?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/bids/"+tenderID+"/list?username="+f.owners[0], nil, http.StatusOK)
+	c.attachments(bid, f.supplier)
+
+	c.do(http.MethodPut, bid+"/withdraw?username="+f.supplier, map[string]string{"reason": "Пересчитываем смету"}, http.StatusOK)
+	c.do(http.MethodGet, bid+"/withdrawals?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodPut, bid+"/resubmit?username="+f.supplier, map[string]string{}, http.StatusOK)
+
+	var scores []map[string]interface{}
+	for _, criterion := range listOf(t, criteria) {
+		scores = append(scores, map[string]interface{}{"criterionId": criterion.ID, "score": 8})
+	}
+	c.do(http.MethodPut, bid+"/scores?username="+f.owners[0], map[string]interface{}{"scores": scores}, http.StatusOK)
+	c.do(http.MethodGet, tender+"/ranking?username="+f.owners[0], nil, http.StatusOK)
+
+	// Отзыв, ответ автора, жалоба и модерация, репутация
+	c.do(http.MethodPut, bid+"/feedback?username="+f.owners[0], map[string]interface{}{
+		"description": "Быстро ответили на вопросы",
+		"ratings":     map[string]int{"quality": 5, "timeliness": 4, "communication": 5},
+	}, http.StatusOK)
+	feedback := listOf(t, c.do(http.MethodGet, bid+"/feedback?username="+f.supplier, nil, http.StatusOK))
+	if len(feedback) == 0 {
+		t.Fatalf("GET %s/feedback: no feedback returned", bid)
+	}
+	feedbackURL := "/api/feedback/" + feedback[0].ID
+	reviews := url.Values{"authorUsername": {f.supplier}, "requesterUsername": {f.owners[0]}}
+	c.do(http.MethodGet, "/api/bids/"+tenderID+"/reviews?"+reviews.Encode(), nil, http.StatusOK)
+	reputation := url.Values{"authorType": {"User"}, "authorId": {f.supplierID}, "username": {f.owners[0]}}
+	c.do(http.MethodGet, "/api/bids/reputation?"+reputation.Encode(), nil, http.StatusOK)
+	c.do(http.MethodPost, feedbackURL+"/replies?username="+f.supplier, map[string]string{"text": "Спасибо за отзыв"}, http.StatusOK)
+	c.do(http.MethodPut, feedbackURL+"/report?username="+f.supplier, map[string]string{"reason": "Оценка занижена"}, http.StatusOK)
+	c.do(http.MethodGet, "/api/moderation/feedback?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodPut, feedbackURL+"/hide?username="+f.owners[0], map[string]string{"reason": "Проверяем жалобу"}, http.StatusOK)
+	c.do(http.MethodPut, feedbackURL+"/restore?username="+f.owners[0], map[string]string{}, http.StatusOK)
+
+	// Решения: отзыв своего решения и кворум из трёх одобрений, после которого появляется договор
+	approve := "/submit_decision?decision=Approved&username="
+	c.idempotent(http.MethodPut, bid+approve+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodPut, bid+"/revoke_decision?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodGet, bid+"/decisions?username="+f.owners[0], nil, http.StatusOK)
+	for _, owner := range f.owners {
+		c.idempotent(http.MethodPut, bid+approve+owner, nil, http.StatusOK)
+	}
+
+	// Договор: этап, подписание, выполнение этапа и завершение
+	awards := listOf(t, c.do(http.MethodGet, tender+"/awards?username="+f.owners[0], nil, http.StatusOK))
+	if len(awards) == 0 {
+		t.Fatalf("GET %s/awards: no award after approval", tender)
+	}
+	award := "/api/awards/" + awards[0].ID
+	c.do(http.MethodGet, award+"?username="+f.supplier, nil, http.StatusOK)
+	var withMilestone struct {
+		Milestones []struct {
+			ID string `json:"id"`
+		} `json:"milestones"`
+	}
+	decode(t, c.do(http.MethodPost, award+"/milestones?username="+f.owners[0], map[string]string{
+		"name":    "Поставка первой партии",
+		"dueDate": time.Now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339),
+	}, http.StatusOK), &withMilestone)
+	if len(withMilestone.Milestones) == 0 {
+		t.Fatalf("POST %s/milestones: milestone not returned", award)
+	}
+	c.do(http.MethodPut, award+"/status?status=Active&username="+f.supplier, map[string]string{}, http.StatusOK)
+	c.do(http.MethodPut, award+"/milestones/"+withMilestone.Milestones[0].ID+"/complete?username="+f.owners[0], nil, http.StatusOK)
+	c.do(http.MethodPut, award+"/status?status=Completed&username="+f.owners[0], map[string]string{}, http.StatusOK)
+	c.do(http.MethodGet, tender+"/audit?username="+f.owners[0], nil, http.StatusOK)
+
+	// Торги: тендер с аукционом и снижение цены
+	auctionTenderID := idOf(t, c.idempotent(http.MethodPost, "/api/tenders/new", map[string]interface{}{
+		"name":            "Ремонт склада",
+		"description":     "Ремонт кровли",
+		"serviceType":     "Construction",
+		"organizationId":  f.customer,
+		"creatorUsername": f.owners[0],
+		"auction": map[string]interface{}{
+			"startsAt":     time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			"endsAt":       time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			"minDecrement": 100,
+		},
+	}, http.StatusOK))
+	c.do(http.MethodPut, "/api/tenders/"+auctionTenderID+"/status?status=Published&username="+f.owners[0], nil, http.StatusOK)
+	auctionBid := "/api/bids/" + idOf(t, c.idempotent(http.MethodPost, "/api/bids/new", map[string]string{
+		"name":        "Ремонт за месяц",
+		"description": "Материалы включены",
+		"tenderId":    auctionTenderID,
+		"authorType":  "User",
+		"authorId":    f.supplierID,
+	}, http.StatusOK))
+	c.do(http.MethodPut, auctionBid+"/status?status=Published&username="+f.supplier, nil, http.StatusOK)
+	c.idempotent(http.MethodPut, auctionBid+"/price?username="+f.supplier, map[string]int{"price": 100000}, http.StatusOK)
+	c.do(http.MethodGet, auctionBid+"/auction?username="+f.supplier, nil, http.StatusOK)
+	c.do(http.MethodGet, "/api/tenders/"+auctionTenderID+"/auction?username="+f.owners[0], nil, http.StatusOK)
+
+	c.do(http.MethodPost, "/graphql?username="+f.owners[0], map[string]interface{}{
+		"query":     `query($id: ID!) { tender(id: $id) { id name bids { id } } }`,
+		"variables": map[string]string{"id": tenderID},
+	}, http.StatusOK)
+
+	c.checkCoverage()
+}
+
+// contract выполняет запросы к роутеру и запоминает, какие маршруты были вызваны
+type contract struct {
+	t       *testing.T
+	router  *chi.Mux
+	covered map[string]bool
+}
+
+// do отправляет запрос с телом body в JSON (если оно есть) и проверяет статус ответа
+func (c *contract) do(method, target string, body interface{}, want int) []byte {
+	c.t.Helper()
+	return c.send(c.request(method, target, body), want)
+}
+
+// idempotent отправляет запрос, как do, с новым ключом идемпотентности
+func (c *contract) idempotent(method, target string, body interface{}, want int) []byte {
+	c.t.Helper()
+	r := c.request(method, target, body)
+	r.Header.Set("Idempotency-Key", uuid.NewString())
+	return c.send(r, want)
+}
+
+// attachments загружает файл к тендеру или предложению base, получает его и удаляет
+func (c *contract) attachments(base, username string) {
+	c.t.Helper()
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", "spec.txt")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	io.WriteString(part, "Техническое задание")
+	form.Close()
+
+	r := httptest.NewRequest(http.MethodPost, base+"/attachments?username="+username, &buf)
+	r.Header.Set("Content-Type", form.FormDataContentType())
+	attachment := base + "/attachments/" + idOf(c.t, c.send(r, http.StatusOK))
+	c.do(http.MethodGet, base+"/attachments?username="+username, nil, http.StatusOK)
+	c.do(http.MethodGet, attachment+"?username="+username, nil, http.StatusOK)
+	c.do(http.MethodDelete, attachment+"?username="+username, nil, http.StatusNoContent)
+}
+
+func (c *contract) request(method, target string, body interface{}) *http.Request {
+	c.t.Helper()
+	if body == nil {
+		return httptest.NewRequest(method, target, nil)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	r := httptest.NewRequest(method, target, bytes.NewReader(data))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
+
+func (c *contract) send(r *http.Request, want int) []byte {
+	c.t.Helper()
+	rctx := chi.NewRouteContext()
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	c.router.ServeHTTP(w, r)
+	c.covered[r.Method+" "+rctx.RoutePattern()] = true
+	if w.Code != want {
+		c.t.Fatalf("%s %s: got status %d, want %d: %s", r.Method, r.URL, w.Code, want, w.Body)
+	}
+	return w.Body.Bytes()
+}
+
+// checkCoverage сверяет вызванные маршруты со всеми маршрутами роутера
+func (c *contract) checkCoverage() {
+	c.t.Helper()
+	var missing []string
+	chi.Walk(c.router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		// /metrics зарегистрирован для всех методов, достаточно GET
+		if route == "/metrics" && method != http.MethodGet {
+			return nil
+		}
+		if !c.covered[method+" "+route] {
+			missing = append(missing, method+" "+route)
+		}
+		return nil
+	})
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		c.t.Errorf("routes not covered by contract test:\n%s", strings.Join(missing, "\n"))
+	}
+}
+
+// fixtures — пользователи и организации, созданные для одного прогона теста
+type fixtures struct {
+	customer   string   // ID организации, проводящей тендеры
+	owners     []string // ответственные за customer; их трое, чтобы набрать кворум одобрений
+	supplier   string   // участник тендеров
+	supplierID string
+	guest      string // отклоняет приглашение
+	outsider   string // его приглашение отзывают
+}
+
+// seedFixtures создаёт пользователей и организации с уникальными именами, чтобы тест можно было
+// запускать повторно на той же базе
+func seedFixtures(t *testing.T, pool *pgxpool.Pool) *fixtures {
+	t.Helper()
+	ctx := context.Background()
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	insert := func(query string, args ...interface{}) string {
+		var id string
+		if err := pool.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+			t.Fatalf("seed fixtures: %v", err)
+		}
+		return id
+	}
+	user := func(name string) (string, string) {
+		username := name + "_" + suffix
+		return username, insert(`INSERT INTO employee (username) VALUES ($1) RETURNING id`, username)
+	}
+	organization := func(name string, responsible ...string) string {
+		id := insert(`INSERT INTO organization (name, type) VALUES ($1, 'LLC') RETURNING id`, name+" "+suffix)
+		for _, userID := range responsible {
+			insert(`INSERT INTO organization_responsible (organization_id, user_id) VALUES ($1, $2) RETURNING id`, id, userID)
+		}
+		return id
+	}
+
+	f := &fixtures{}
+	var ownerIDs []string
+	for _, name := range []string{"owner1", "owner2", "owner3"} {
+		username, id := user(name)
+		f.owners = append(f.owners, username)
+		ownerIDs = append(ownerIDs, id)
+	}
+	f.customer = organization("Заказчик", ownerIDs...)
+	f.supplier, f.supplierID = user("supplier")
+	organization("Поставщик", f.supplierID)
+	f.guest, _ = user("guest")
+	f.outsider, _ = user("outsider")
+	return f
+}
+
+type withID struct {
+	ID string `json:"id"`
+}
+
+func idOf(t *testing.T, body []byte) string {
+	t.Helper()
+	var v withID
+	decode(t, body, &v)
+	if v.ID == "" {
+		t.Fatalf("response has no id: %s", body)
+	}
+	return v.ID
+}
+
+func listOf(t *testing.T, body []byte) []withID {
+	t.Helper()
+	var v []withID
+	decode(t, body, &v)
+	return v
+}
+
+func decode(t *testing.T, body []byte, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(body, v); err != nil {
+		t.Fatalf("decode response %s: %v", body, err)
+	}
+}
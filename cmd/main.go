@@ -1,52 +1,293 @@
 package main
 
-import (	
+import (
+	"context"
+	"flag"
+	"fmt"
 	"github.com/go-chi/chi/v5"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"tender-service/config"
 	"tender-service/internal/database"
+	"tender-service/internal/graphql"
+	"tender-service/internal/grpcserver"
 	"tender-service/internal/handlers"
+	"tender-service/internal/health"
+	"tender-service/internal/idempotency"
+	"tender-service/internal/logger"
+	"tender-service/internal/metrics"
+	"tender-service/internal/openapi"
+	"tender-service/internal/sealing"
+	"tender-service/internal/service"
+	"tender-service/internal/storage"
+	"tender-service/internal/tracing"
+	"tender-service/internal/worker"
+	"time"
 )
 
-func setupRoutes() *chi.Mux {
+func setupRoutes(validator *openapi.Validator, idem *idempotency.Store) *chi.Mux {
 	r := chi.NewRouter()
-	r.Get("/api/ping", handlers.PingHandler)                                 // Проверка доступности сервера
-	r.Post("/api/tenders/new", handlers.CreateTenderHandler)                 // Создание нового тендера
-	r.Get("/api/tenders/{tenderId}/status", handlers.GetTenderStatusHandler) // Получение статуса тендера
+	r.Use(logger.RequestID)
+	r.Use(tracing.Middleware)
+	r.Use(logger.AccessLog)
+	r.Use(metrics.Middleware)
+	r.Use(validator.Middleware)
+	r.Handle("/metrics", metrics.Handler())                                        // Метрики в формате Prometheus
+	r.Get("/healthz", health.LivenessHandler)                                      // Процесс жив
+	r.Get("/readyz", health.ReadinessHandler)                                      // Сервис готов принимать запросы
+	r.Get("/api/openapi.yml", openapi.SpecHandler)                                 // Спецификация API
+	r.Post("/graphql", graphql.Handler)                                            // Запросы GraphQL
+	r.Get("/api/ping", handlers.PingHandler)                                       // Проверка доступности сервера
+	r.With(idem.Middleware).Post("/api/tenders/new", handlers.CreateTenderHandler) // Создание нового тендера
+	r.Get("/api/tenders/{tenderId}/status", handlers.GetTenderStatusHandler)       // Получение статуса тендера
 	r.Put("/api/tenders/{tenderId}/status", handlers.UpdateTenderStatusHandler)
 	r.Get("/api/tenders", handlers.GetTendersHandler)                                   // Получение списка тендеров
 	r.Get("/api/tenders/my", handlers.GetUserTendersHandler)                            // Получение тендеров пользователя
 	r.Patch("/api/tenders/{tenderId}/edit", handlers.EditTenderHandler)                 // Редактирование тендера
 	r.Put("/api/tenders/{tenderId}/rollback/{version}", handlers.RollbackTenderHandler) // Откат тендера к версии
-	r.Post("/api/bids/new", handlers.CreateBidHandler)                                  // Создание нового предложения
+	r.Get("/api/tenders/{tenderId}/lots", handlers.GetTenderLotsHandler)                // Лоты тендера
+	r.With(idem.Middleware).Post("/api/bids/new", handlers.CreateBidHandler)            // Создание нового предложения
 	r.Get("/api/bids/my", handlers.GetUserBidsHandler)                                  // Получение предложений пользователя
 	r.Get("/api/bids/{tenderId}/list", handlers.GetBidsForTenderHandler)                // Получение предложений для тендера
 	r.Get("/api/bids/{bidId}/status", handlers.GetBidStatusHandler)                     // Получение статуса предложения
 	r.Put("/api/bids/{bidId}/status", handlers.UpdateBidStatusHandler)
-	r.Put("/api/bids/{bidId}/submit_decision", handlers.SubmitBidDecisionHandler) // Отправка решения по предложению
+	r.With(idem.Middleware).Put("/api/bids/{bidId}/submit_decision", handlers.SubmitBidDecisionHandler) // Отправка решения по предложению
 	r.Patch("/api/bids/{bidId}/edit", handlers.EditBidHandler)
 	r.Put("/api/bids/{bidId}/rollback/{version}", handlers.RollbackBidHandler)
 	r.Put("/api/bids/{bidId}/feedback", handlers.SubmitBidFeedbackHandler)
 	r.Get("/api/bids/{tenderId}/reviews", handlers.GetBidReviewsHandler)
+	r.Get("/api/bids/reputation", handlers.GetReputationHandler)        // Репутация автора предложений
+	r.Get("/api/bids/{bidId}/feedback", handlers.GetBidFeedbackHandler) // Отзывы на предложение с ответами автора
+
+	r.Post("/api/feedback/{feedbackId}/replies", handlers.ReplyToFeedbackHandler) // Ответ автора предложения на отзыв
+	r.Put("/api/feedback/{feedbackId}/report", handlers.ReportFeedbackHandler)    // Жалоба на отзыв или ответ
+	r.Put("/api/feedback/{feedbackId}/hide", handlers.HideFeedbackHandler)        // Скрытие отзыва или ответа модератором
+	r.Put("/api/feedback/{feedbackId}/restore", handlers.RestoreFeedbackHandler)  // Возврат скрытого отзыва или ответа
+	r.Get("/api/moderation/feedback", handlers.GetModerationQueueHandler)         // Очередь жалоб на отзывы
+
+	r.Get("/api/tenders/{tenderId}/awards", handlers.GetTenderAwardsHandler)                                 // Договоры по тендеру
+	r.Get("/api/awards/{awardId}", handlers.GetAwardHandler)                                                 // Договор по присуждению
+	r.Put("/api/awards/{awardId}/status", handlers.UpdateAwardStatusHandler)                                 // Подписание, завершение, расторжение
+	r.Post("/api/awards/{awardId}/milestones", handlers.AddAwardMilestoneHandler)                            // Этап исполнения договора
+	r.Put("/api/awards/{awardId}/milestones/{milestoneId}/complete", handlers.CompleteAwardMilestoneHandler) // Выполнение этапа
+
+	r.Post("/api/tenders/{tenderId}/invitations", handlers.InviteToTenderHandler)                          // Приглашение к тендеру
+	r.Get("/api/tenders/{tenderId}/invitations", handlers.GetTenderInvitationsHandler)                     // Приглашения к тендеру
+	r.Delete("/api/tenders/{tenderId}/invitations/{invitationId}", handlers.RevokeTenderInvitationHandler) // Отзыв приглашения
+	r.Get("/api/invitations/my", handlers.GetUserInvitationsHandler)                                       // Приглашения пользователя и его организаций
+	r.Put("/api/invitations/{invitationId}/accept", handlers.AcceptInvitationHandler)                      // Принятие приглашения
+	r.Put("/api/invitations/{invitationId}/decline", handlers.DeclineInvitationHandler)                    // Отказ от приглашения
+
+	r.Post("/api/tenders/{tenderId}/attachments", handlers.UploadTenderAttachmentHandler)                  // Загрузка файла тендера
+	r.Get("/api/tenders/{tenderId}/attachments", handlers.GetTenderAttachmentsHandler)                     // Файлы тендера
+	r.Get("/api/tenders/{tenderId}/attachments/{attachmentId}", handlers.DownloadTenderAttachmentHandler)  // Скачивание файла тендера
+	r.Delete("/api/tenders/{tenderId}/attachments/{attachmentId}", handlers.DeleteTenderAttachmentHandler) // Удаление файла тендера
+	r.Post("/api/bids/{bidId}/attachments", handlers.UploadBidAttachmentHandler)                           // Загрузка файла предложения
+	r.Get("/api/bids/{bidId}/attachments", handlers.GetBidAttachmentsHandler)                              // Файлы предложения
+	r.Get("/api/bids/{bidId}/attachments/{attachmentId}", handlers.DownloadBidAttachmentHandler)           // Скачивание файла предложения
+	r.Delete("/api/bids/{bidId}/attachments/{attachmentId}", handlers.DeleteBidAttachmentHandler)          // Удаление файла предложения
+
+	r.Post("/api/tenders/{tenderId}/clarifications", handlers.AskClarificationHandler)                            // Вопрос по тендеру
+	r.Get("/api/tenders/{tenderId}/clarifications", handlers.GetClarificationsHandler)                            // Вопросы и ответы по тендеру
+	r.Put("/api/tenders/{tenderId}/clarifications/{clarificationId}/answer", handlers.AnswerClarificationHandler) // Ответ на вопрос
+
+	r.Put("/api/tenders/{tenderId}/criteria", handlers.SetEvaluationCriteriaHandler) // Критерии оценки тендера
+	r.Get("/api/tenders/{tenderId}/criteria", handlers.GetEvaluationCriteriaHandler)
+	r.Get("/api/tenders/{tenderId}/ranking", handlers.GetBidRankingHandler) // Рейтинг предложений по оценкам
+	r.Put("/api/bids/{bidId}/scores", handlers.SubmitBidScoresHandler)      // Оценка предложения по критериям
+
+	r.Get("/api/tenders/{tenderId}/audit", handlers.GetTenderAuditHandler) // Журнал событий тендера
+
+	r.Get("/api/tenders/{tenderId}/auction", handlers.GetAuctionHandler)                       // Торги по тендеру
+	r.With(idem.Middleware).Put("/api/bids/{bidId}/price", handlers.SubmitAuctionPriceHandler) // Снижение цены на торгах
+	r.Get("/api/bids/{bidId}/auction", handlers.GetAuctionPositionHandler)                     // Место предложения на торгах
+
+	r.Put("/api/bids/{bidId}/withdraw", handlers.WithdrawBidHandler)          // Отзыв предложения
+	r.Put("/api/bids/{bidId}/resubmit", handlers.ResubmitBidHandler)          // Повторная подача отозванного предложения
+	r.Get("/api/bids/{bidId}/withdrawals", handlers.GetBidWithdrawalsHandler) // История отзывов предложения
+
+	r.Put("/api/bids/{bidId}/revoke_decision", handlers.RevokeBidDecisionHandler) // Отзыв своего решения по предложению
+	r.Get("/api/bids/{bidId}/decisions", handlers.GetBidDecisionsHandler)         // История решений по предложению
 
 	return r
 }
 
 func main() {
-	_, err := database.ConnectPostgres()
+	configPath := flag.String("config", "", "путь к файлу конфигурации YAML или TOML (по умолчанию из CONFIG_FILE)")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := logger.Setup(cfg.Log.Level, cfg.Log.Format); err != nil {
+		fmt.Fprintf(os.Stderr, "Ошибка настройки логирования: %v\n", err)
+		os.Exit(1)
+	}
+	if err := run(cfg); err != nil {
+		slog.Error("server stopped with error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg *config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		Protocol:    cfg.Tracing.Protocol,
+		ServiceName: cfg.Tracing.ServiceName,
+	})
+	if err != nil {
+		return fmt.Errorf("ошибка настройки трассировки: %w", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	if _, err := database.ConnectPostgres(ctx, cfg.Postgres); err != nil {
+		return fmt.Errorf("ошибка подключения к базе данных: %w", err)
+	}
+	defer database.Close()
+	if err := database.CreateTables(ctx); err != nil {
+		return fmt.Errorf("ошибка создания таблиц: %w", err)
+	}
+	health.Register("database", database.Ping)
+	health.Register("migrations", database.CheckSchema)
+
+	attachments, err := storage.New(ctx, storage.Config{
+		Backend: cfg.Attachments.Storage,
+		Dir:     cfg.Attachments.Dir,
+		S3: storage.S3Config{
+			Endpoint:  cfg.Attachments.S3.Endpoint,
+			Bucket:    cfg.Attachments.S3.Bucket,
+			Region:    cfg.Attachments.S3.Region,
+			AccessKey: cfg.Attachments.S3.AccessKey,
+			SecretKey: cfg.Attachments.S3.SecretKey,
+			UseSSL:    cfg.Attachments.S3.UseSSL,
+		},
+	})
 	if err != nil {
-		log.Fatalf("Ошибка подключения к базе данных: %v", err)
+		return fmt.Errorf("ошибка настройки хранилища вложений: %w", err)
 	}
-	database.CreateTables()
+	service.SetupAttachments(attachments, cfg.Attachments.MaxSize)
+	health.Register("attachments", attachments.Ping)
 
-	r := setupRoutes()
-	address := config.LoadConfig().ServerAddress
-	if address == "" {
-		address = "0.0.0.0:8080"
+	var keyring *sealing.Keyring
+	if cfg.Sealing.MasterKey != "" {
+		masterKey, err := sealing.ParseMasterKey(cfg.Sealing.MasterKey)
+		if err == nil {
+			keyring, err = sealing.NewKeyring(masterKey)
+		}
+		if err != nil {
+			return fmt.Errorf("ошибка настройки запечатанных тендеров: %w", err)
+		}
+		service.SetupSealing(keyring)
 	}
-	log.Printf("Сервер запущен по адресу %s", address)
-	if err := http.ListenAndServe(address, r); err != nil {
-		log.Fatalf("Ошибка при запуске сервера: %v", err)
+
+	service.SetupEditPolicy(service.EditPolicy(strings.ToLower(cfg.Decisions.EditPolicy)))
+	service.SetupModeration(cfg.Moderation.Admins)
+
+	validator, err := openapi.New(cfg.OpenAPI.ValidateRequests, cfg.OpenAPI.ValidateResponses)
+	if err != nil {
+		return err
+	}
+
+	idem := idempotency.New(cfg.Idempotency.TTL, cfg.Idempotency.LeaseTimeout)
+
+	workers := worker.NewGroup(context.Background())
+	workers.Every("idempotency-cleanup", cfg.Idempotency.CleanupInterval, idem.Cleanup)
+	if keyring != nil {
+		workers.Every("sealed-opening", cfg.Sealing.OpenInterval, service.OpenDueSealedTenders)
+	}
+	workers.Every("auction-close", cfg.Auction.CloseInterval, service.CloseDueAuctions)
+
+	server := &http.Server{
+		Addr:              cfg.Server.Address,
+		Handler:           setupRoutes(validator, idem),
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+		MaxHeaderBytes:    cfg.Server.MaxHeaderBytes,
+	}
+
+	serverErr := make(chan error, 2)
+	go func() {
+		slog.Info("server started", "address", cfg.Server.Address)
+		serverErr <- server.ListenAndServe()
+	}()
+
+	var grpcServer *grpcserver.Server
+	if cfg.GRPC.Address != "" {
+		listener, err := net.Listen("tcp", cfg.GRPC.Address)
+		if err != nil {
+			server.Close()
+			workers.Stop(context.Background())
+			return fmt.Errorf("ошибка при запуске gRPC-сервера: %w", err)
+		}
+		grpcServer = grpcserver.New(cfg.GRPC.Reflection)
+		grpcServer.SyncHealth(ctx)
+		workers.Every("grpc-health", 5*time.Second, grpcServer.SyncHealth)
+		go func() {
+			slog.Info("grpc server started", "address", cfg.GRPC.Address)
+			serverErr <- grpcServer.Serve(listener)
+		}()
+	}
+
+	select {
+	case err := <-serverErr:
+		server.Close()
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+		workers.Stop(context.Background())
+		return fmt.Errorf("ошибка при запуске сервера: %w", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	// Сначала /readyz начинает отвечать 503, чтобы балансировщик убрал экземпляр из ротации,
+	// затем сервер перестаёт принимать соединения и дожидается запросов в обработке
+	health.SetDraining()
+	if grpcServer != nil {
+		grpcServer.Drain()
+	}
+	slog.Info("shutdown started, draining", "delay", cfg.Server.DrainDelay)
+	time.Sleep(cfg.Server.DrainDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("http server shutdown incomplete", "error", err)
+	}
+	if grpcServer != nil {
+		stopGRPC(shutdownCtx, grpcServer)
+	}
+	if err := workers.Stop(shutdownCtx); err != nil {
+		slog.Error("background workers shutdown incomplete", "error", err)
+	}
+	slog.Info("server stopped")
+	return nil
+}
+
+// stopGRPC дожидается завершения вызовов в обработке, а по истечении ctx обрывает их
+func stopGRPC(ctx context.Context, server *grpcserver.Server) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Error("grpc server shutdown incomplete", "error", ctx.Err())
+		server.Stop()
 	}
 }
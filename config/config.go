@@ -1,29 +1,242 @@
 package config
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
 )
 
+// ConfigFileEnv задаёт путь к необязательному файлу конфигурации (YAML или TOML)
+const ConfigFileEnv = "CONFIG_FILE"
+
+// Config собирается в три слоя: значения по умолчанию, файл конфигурации, переменные окружения.
+// Каждый следующий слой переопределяет предыдущий.
 type Config struct {
-	ServerAddress   string
-	PostgresConn    string
-	PostgresJDBCURL string
-	PostgresUser    string
-	PostgresPass    string
-	PostgresHost    string
-	PostgresPort    string
-	PostgresDB      string
+	Server      ServerConfig      `yaml:"server" toml:"server"`
+	GRPC        GRPCConfig        `yaml:"grpc" toml:"grpc"`
+	Postgres    PostgresConfig    `yaml:"postgres" toml:"postgres"`
+	Log         LogConfig         `yaml:"log" toml:"log"`
+	Tracing     TracingConfig     `yaml:"tracing" toml:"tracing"`
+	OpenAPI     OpenAPIConfig     `yaml:"openapi" toml:"openapi"`
+	Idempotency IdempotencyConfig `yaml:"idempotency" toml:"idempotency"`
+	Attachments AttachmentsConfig `yaml:"attachments" toml:"attachments"`
+	Sealing     SealingConfig     `yaml:"sealing" toml:"sealing"`
+	Auction     AuctionConfig     `yaml:"auction" toml:"auction"`
+	Decisions   DecisionsConfig   `yaml:"decisions" toml:"decisions"`
+	Moderation  ModerationConfig  `yaml:"moderation" toml:"moderation"`
+}
+
+type ServerConfig struct {
+	Address           string        `yaml:"address" toml:"address" env:"SERVER_ADDRESS"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" toml:"read_timeout" env:"HTTP_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" toml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" toml:"write_timeout" env:"HTTP_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" toml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes" toml:"max_header_bytes" env:"HTTP_MAX_HEADER_BYTES"`
+	DrainDelay        time.Duration `yaml:"drain_delay" toml:"drain_delay" env:"DRAIN_DELAY"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+}
+
+// GRPCConfig настраивает gRPC-сервер; пустой адрес отключает его
+type GRPCConfig struct {
+	Address    string `yaml:"address" toml:"address" env:"GRPC_ADDRESS"`
+	Reflection bool   `yaml:"reflection" toml:"reflection" env:"GRPC_REFLECTION"`
+}
+
+type PostgresConfig struct {
+	Conn     string `yaml:"conn" toml:"conn" env:"POSTGRES_CONN"`
+	JDBCURL  string `yaml:"jdbc_url" toml:"jdbc_url" env:"POSTGRES_JDBC_URL"`
+	Username string `yaml:"username" toml:"username" env:"POSTGRES_USERNAME"`
+	Password string `yaml:"password" toml:"password" env:"POSTGRES_PASSWORD"`
+	Host     string `yaml:"host" toml:"host" env:"POSTGRES_HOST"`
+	Port     int    `yaml:"port" toml:"port" env:"POSTGRES_PORT"`
+	Database string `yaml:"database" toml:"database" env:"POSTGRES_DATABASE"`
+	SSLMode  string `yaml:"sslmode" toml:"sslmode" env:"POSTGRES_SSLMODE"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level" toml:"level" env:"LOG_LEVEL"`
+	Format string `yaml:"format" toml:"format" env:"LOG_FORMAT"`
+}
+
+type TracingConfig struct {
+	Exporter    string `yaml:"exporter" toml:"exporter" env:"OTEL_TRACES_EXPORTER"`
+	Protocol    string `yaml:"protocol" toml:"protocol" env:"OTEL_EXPORTER_OTLP_PROTOCOL"`
+	ServiceName string `yaml:"service_name" toml:"service_name" env:"OTEL_SERVICE_NAME"`
+}
+
+// OpenAPIConfig управляет проверкой запросов и ответов по спецификации api/openapi.yml.
+// Проверка ответов нужна в тестовом окружении: расхождение со схемой превращается в ответ 500.
+type OpenAPIConfig struct {
+	ValidateRequests  bool `yaml:"validate_requests" toml:"validate_requests" env:"OPENAPI_VALIDATE_REQUESTS"`
+	ValidateResponses bool `yaml:"validate_responses" toml:"validate_responses" env:"OPENAPI_VALIDATE_RESPONSES"`
+}
+
+// IdempotencyConfig задаёт срок хранения ответов на запросы с заголовком Idempotency-Key,
+// срок резерва ключа за выполняющимся запросом и период фоновой очистки просроченных ключей
+type IdempotencyConfig struct {
+	TTL             time.Duration `yaml:"ttl" toml:"ttl" env:"IDEMPOTENCY_TTL"`
+	LeaseTimeout    time.Duration `yaml:"lease_timeout" toml:"lease_timeout" env:"IDEMPOTENCY_LEASE_TIMEOUT"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval" toml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+}
+
+// AttachmentsConfig выбирает хранилище файлов, прикреплённых к тендерам и предложениям:
+// локальный каталог (storage: local) или бакет S3-совместимого хранилища (storage: s3)
+type AttachmentsConfig struct {
+	Storage string   `yaml:"storage" toml:"storage" env:"ATTACHMENTS_STORAGE"`
+	Dir     string   `yaml:"dir" toml:"dir" env:"ATTACHMENTS_DIR"`
+	MaxSize int64    `yaml:"max_size" toml:"max_size" env:"ATTACHMENTS_MAX_SIZE"`
+	S3      S3Config `yaml:"s3" toml:"s3"`
+}
+
+type S3Config struct {
+	Endpoint  string `yaml:"endpoint" toml:"endpoint" env:"ATTACHMENTS_S3_ENDPOINT"`
+	Bucket    string `yaml:"bucket" toml:"bucket" env:"ATTACHMENTS_S3_BUCKET"`
+	Region    string `yaml:"region" toml:"region" env:"ATTACHMENTS_S3_REGION"`
+	AccessKey string `yaml:"access_key" toml:"access_key" env:"ATTACHMENTS_S3_ACCESS_KEY"`
+	SecretKey string `yaml:"secret_key" toml:"secret_key" env:"ATTACHMENTS_S3_SECRET_KEY"`
+	UseSSL    bool   `yaml:"use_ssl" toml:"use_ssl" env:"ATTACHMENTS_S3_USE_SSL"`
 }
 
-func LoadConfig() *Config {
+// SealingConfig настраивает запечатанные тендеры. MasterKey — 32 байта в base64, которыми шифруются
+// ключи тендеров; без него запечатанные тендеры создавать нельзя. OpenInterval — период фоновой
+// проверки тендеров, у которых истёк срок подачи и предложения пора вскрыть.
+type SealingConfig struct {
+	MasterKey    string        `yaml:"master_key" toml:"master_key" env:"SEALING_MASTER_KEY"`
+	OpenInterval time.Duration `yaml:"open_interval" toml:"open_interval" env:"SEALING_OPEN_INTERVAL"`
+}
+
+// AuctionConfig настраивает торги. CloseInterval — период фоновой проверки торгов,
+// время которых истекло и по которым пора определить победителя.
+type AuctionConfig struct {
+	CloseInterval time.Duration `yaml:"close_interval" toml:"close_interval" env:"AUCTION_CLOSE_INTERVAL"`
+}
+
+// DecisionsConfig настраивает согласование предложений. EditPolicy определяет, можно ли менять
+// опубликованное предложение, по которому уже есть решения: allow — можно, но одобрения прежней
+// версии перестают учитываться в кворуме; lock — нельзя до окончательного решения.
+type DecisionsConfig struct {
+	EditPolicy string `yaml:"edit_policy" toml:"edit_policy" env:"BID_EDIT_POLICY"`
+}
+
+// ModerationConfig настраивает модерацию отзывов. Admins — имена пользователей-администраторов
+// платформы, которые разбирают жалобы по всем тендерам; ответственные за организацию
+// модерируют отзывы только по тендерам своей организации.
+type ModerationConfig struct {
+	Admins []string `yaml:"admins" toml:"admins" env:"MODERATION_ADMINS"`
+}
+
+// Default возвращает конфигурацию по умолчанию. Учётных данных в ней нет:
+// подключение к базе данных обязательно задаётся файлом или окружением.
+func Default() *Config {
 	return &Config{
-		ServerAddress:   os.Getenv("SERVER_ADDRESS"),
-		PostgresConn:    os.Getenv("POSTGRES_CONN"),
-		PostgresJDBCURL: os.Getenv("POSTGRES_JDBC_URL"),
-		PostgresUser:    os.Getenv("POSTGRES_USERNAME"),
-		PostgresPass:    os.Getenv("POSTGRES_PASSWORD"),
-		PostgresHost:    os.Getenv("POSTGRES_HOST"),
-		PostgresPort:    os.Getenv("POSTGRES_PORT"),
-		PostgresDB:      os.Getenv("POSTGRES_DATABASE"),
+		Server: ServerConfig{
+			Address:           "0.0.0.0:8080",
+			ReadTimeout:       10 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       120 * time.Second,
+			MaxHeaderBytes:    1 << 20,
+			DrainDelay:        5 * time.Second,
+			ShutdownTimeout:   30 * time.Second,
+		},
+		GRPC: GRPCConfig{
+			Address:    "0.0.0.0:9090",
+			Reflection: true,
+		},
+		Postgres: PostgresConfig{
+			Port: 5432,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			Protocol:    "grpc",
+			ServiceName: "tender-service",
+		},
+		OpenAPI: OpenAPIConfig{
+			ValidateRequests: true,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             24 * time.Hour,
+			LeaseTimeout:    5 * time.Minute,
+			CleanupInterval: 10 * time.Minute,
+		},
+		Attachments: AttachmentsConfig{
+			Storage: "local",
+			Dir:     "data/attachments",
+			MaxSize: 20 << 20,
+			S3: S3Config{
+				UseSSL: true,
+			},
+		},
+		Sealing: SealingConfig{
+			OpenInterval: time.Minute,
+		},
+		Auction: AuctionConfig{
+			CloseInterval: 10 * time.Second,
+		},
+		Decisions: DecisionsConfig{
+			EditPolicy: "allow",
+		},
+	}
+}
+
+// Load собирает конфигурацию из значений по умолчанию, файла path (если задан,
+// иначе из переменной CONFIG_FILE) и переменных окружения, после чего проверяет её
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv(ConfigFileEnv)
+	}
+	if path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyEnv(cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("не удалось прочитать файл конфигурации: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("ошибка разбора %s: %w", path, err)
+		}
+	case ".toml":
+		meta, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return fmt.Errorf("ошибка разбора %s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("ошибка разбора %s: неизвестный параметр %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("неподдерживаемый формат файла конфигурации %s, допустимые расширения: .yaml, .yml, .toml", path)
 	}
+	return nil
 }
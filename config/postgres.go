@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const jdbcPrefix = "jdbc:postgresql://"
+
+// DSN возвращает строку подключения к PostgreSQL. Приоритет источников:
+// POSTGRES_CONN, затем POSTGRES_JDBC_URL с учётными данными из POSTGRES_USERNAME/POSTGRES_PASSWORD,
+// затем отдельные параметры POSTGRES_HOST, POSTGRES_PORT, POSTGRES_DATABASE.
+func (c PostgresConfig) DSN() (string, error) {
+	if c.Conn != "" {
+		return c.Conn, nil
+	}
+
+	host, port, database := c.Host, c.Port, c.Database
+	username, password, sslmode := c.Username, c.Password, c.SSLMode
+
+	if c.JDBCURL != "" {
+		parsed, err := parseJDBCURL(c.JDBCURL)
+		if err != nil {
+			return "", err
+		}
+		host, database = parsed.Hostname(), strings.TrimPrefix(parsed.Path, "/")
+		if p := parsed.Port(); p != "" {
+			if port, err = strconv.Atoi(p); err != nil {
+				return "", fmt.Errorf("некорректный порт в JDBC URL: %q", p)
+			}
+		}
+		params := parsed.Query()
+		if username == "" {
+			username = params.Get("user")
+		}
+		if password == "" {
+			password = params.Get("password")
+		}
+		if sslmode == "" {
+			sslmode = params.Get("sslmode")
+		}
+	}
+
+	dsn := url.URL{
+		Scheme: "postgres",
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   "/" + database,
+	}
+	if password != "" {
+		dsn.User = url.UserPassword(username, password)
+	} else {
+		dsn.User = url.User(username)
+	}
+	if sslmode != "" {
+		dsn.RawQuery = url.Values{"sslmode": {sslmode}}.Encode()
+	}
+	return dsn.String(), nil
+}
+
+func parseJDBCURL(jdbcURL string) (*url.URL, error) {
+	if !strings.HasPrefix(jdbcURL, jdbcPrefix) {
+		return nil, fmt.Errorf("JDBC URL должен начинаться с %s", jdbcPrefix)
+	}
+	parsed, err := url.Parse(strings.TrimPrefix(jdbcURL, "jdbc:"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректный JDBC URL: %w", err)
+	}
+	if parsed.Hostname() == "" || strings.Trim(parsed.Path, "/") == "" {
+		return nil, fmt.Errorf("JDBC URL должен содержать хост и имя базы данных")
+	}
+	return parsed, nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPostgresDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     PostgresConfig
+		want    string
+		wantErr string
+	}{
+		{
+			name: "строка подключения важнее остальных параметров",
+			cfg:  PostgresConfig{Conn: "postgres://app@db:5432/tenders", Host: "other", Port: 6432},
+			want: "postgres://app@db:5432/tenders",
+		},
+		{
+			name: "отдельные параметры",
+			cfg:  PostgresConfig{Host: "db", Port: 5432, Database: "tenders", Username: "app", Password: "secret", SSLMode: "disable"},
+			want: "postgres://app:secret@db:5432/tenders?sslmode=disable",
+		},
+		{
+			name: "пароль со спецсимволами экранируется",
+			cfg:  PostgresConfig{Host: "db", Port: 5432, Database: "tenders", Username: "app", Password: "p@ss/word"},
+			want: "postgres://app:p%40ss%2Fword@db:5432/tenders",
+		},
+		{
+			name: "IPv6-адрес хоста",
+			cfg:  PostgresConfig{Host: "::1", Port: 5432, Database: "tenders", Username: "app"},
+			want: "postgres://app@[::1]:5432/tenders",
+		},
+		{
+			name: "JDBC URL с учётными данными в параметрах",
+			cfg:  PostgresConfig{JDBCURL: "jdbc:postgresql://db:6432/tenders?user=app&password=secret&sslmode=require", Port: 5432},
+			want: "postgres://app:secret@db:6432/tenders?sslmode=require",
+		},
+		{
+			name: "учётные данные из переменных важнее параметров JDBC URL",
+			cfg:  PostgresConfig{JDBCURL: "jdbc:postgresql://db/tenders?user=jdbc&password=jdbc", Port: 5432, Username: "app", Password: "secret"},
+			want: "postgres://app:secret@db:5432/tenders",
+		},
+		{
+			name:    "JDBC URL без префикса",
+			cfg:     PostgresConfig{JDBCURL: "postgresql://db:5432/tenders"},
+			wantErr: "JDBC URL должен начинаться",
+		},
+		{
+			name:    "JDBC URL без имени базы",
+			cfg:     PostgresConfig{JDBCURL: "jdbc:postgresql://db:5432/"},
+			wantErr: "должен содержать хост и имя базы данных",
+		},
+		{
+			name:    "некорректный порт в JDBC URL",
+			cfg:     PostgresConfig{JDBCURL: "jdbc:postgresql://db:port/tenders"},
+			wantErr: "некорректный JDBC URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.DSN()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DSN() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DSN() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DSN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
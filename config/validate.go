@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+
+	"tender-service/internal/sealing"
+)
+
+// Validate проверяет конфигурацию целиком и возвращает все найденные ошибки сразу
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(field, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Server.Address); err != nil {
+		add("server.address", "ожидается host:port, получено %q", c.Server.Address)
+	}
+	for _, timeout := range []struct {
+		field string
+		value time.Duration
+	}{
+		{"server.read_timeout", c.Server.ReadTimeout},
+		{"server.read_header_timeout", c.Server.ReadHeaderTimeout},
+		{"server.write_timeout", c.Server.WriteTimeout},
+		{"server.idle_timeout", c.Server.IdleTimeout},
+		{"server.shutdown_timeout", c.Server.ShutdownTimeout},
+		{"idempotency.ttl", c.Idempotency.TTL},
+		{"idempotency.lease_timeout", c.Idempotency.LeaseTimeout},
+		{"idempotency.cleanup_interval", c.Idempotency.CleanupInterval},
+		{"sealing.open_interval", c.Sealing.OpenInterval},
+		{"auction.close_interval", c.Auction.CloseInterval},
+	} {
+		if timeout.value <= 0 {
+			add(timeout.field, "должно быть больше нуля")
+		}
+	}
+	if c.Idempotency.LeaseTimeout > c.Idempotency.TTL {
+		add("idempotency.lease_timeout", "не может быть больше idempotency.ttl")
+	}
+	if c.Server.DrainDelay < 0 {
+		add("server.drain_delay", "не может быть отрицательным")
+	}
+	if c.Server.MaxHeaderBytes <= 0 {
+		add("server.max_header_bytes", "должно быть больше нуля")
+	}
+
+	if c.GRPC.Address != "" {
+		if _, _, err := net.SplitHostPort(c.GRPC.Address); err != nil {
+			add("grpc.address", "ожидается host:port, получено %q", c.GRPC.Address)
+		}
+	}
+
+	errs = append(errs, c.Postgres.validate()...)
+
+	if !oneOf(strings.ToLower(c.Log.Level), "debug", "info", "warn", "error") {
+		add("log.level", "допустимые значения: debug, info, warn, error; получено %q", c.Log.Level)
+	}
+	if !oneOf(strings.ToLower(c.Log.Format), "json", "text") {
+		add("log.format", "допустимые значения: json, text; получено %q", c.Log.Format)
+	}
+	if !oneOf(strings.ToLower(c.Decisions.EditPolicy), "allow", "lock") {
+		add("decisions.edit_policy", "допустимые значения: allow, lock; получено %q", c.Decisions.EditPolicy)
+	}
+	if !oneOf(strings.ToLower(c.Tracing.Exporter), "none", "otlp", "stdout") {
+		add("tracing.exporter", "допустимые значения: none, otlp, stdout; получено %q", c.Tracing.Exporter)
+	}
+	if !oneOf(strings.ToLower(c.Tracing.Protocol), "grpc", "http/protobuf", "http") {
+		add("tracing.protocol", "допустимые значения: grpc, http/protobuf; получено %q", c.Tracing.Protocol)
+	}
+
+	switch strings.ToLower(c.Attachments.Storage) {
+	case "local":
+		if c.Attachments.Dir == "" {
+			add("attachments.dir", "не задан (ATTACHMENTS_DIR)")
+		}
+	case "s3":
+		if c.Attachments.S3.Endpoint == "" {
+			add("attachments.s3.endpoint", "не задан (ATTACHMENTS_S3_ENDPOINT)")
+		}
+		if c.Attachments.S3.Bucket == "" {
+			add("attachments.s3.bucket", "не задан (ATTACHMENTS_S3_BUCKET)")
+		}
+	default:
+		add("attachments.storage", "допустимые значения: local, s3; получено %q", c.Attachments.Storage)
+	}
+	if c.Attachments.MaxSize <= 0 {
+		add("attachments.max_size", "должно быть больше нуля")
+	}
+	if c.Sealing.MasterKey != "" {
+		if _, err := sealing.ParseMasterKey(c.Sealing.MasterKey); err != nil {
+			add("sealing.master_key", "%v", err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("некорректная конфигурация:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (c PostgresConfig) validate() []error {
+	var errs []error
+	add := func(field, message string) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, message))
+	}
+
+	if c.Conn == "" && c.JDBCURL == "" {
+		if c.Host == "" {
+			add("postgres.host", "не задан (POSTGRES_HOST), либо укажите POSTGRES_CONN или POSTGRES_JDBC_URL")
+		}
+		if c.Database == "" {
+			add("postgres.database", "не задано (POSTGRES_DATABASE)")
+		}
+		if c.Port <= 0 || c.Port > 65535 {
+			add("postgres.port", fmt.Sprintf("должен быть в диапазоне 1-65535, получено %d", c.Port))
+		}
+	}
+	if c.Conn == "" && c.Username == "" && !jdbcHasUser(c.JDBCURL) {
+		add("postgres.username", "не задано (POSTGRES_USERNAME)")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	dsn, err := c.DSN()
+	if err != nil {
+		return []error{fmt.Errorf("postgres: %w", err)}
+	}
+	if _, err := pgconn.ParseConfig(dsn); err != nil {
+		// pgconn сам скрывает пароль в тексте ошибки
+		return []error{fmt.Errorf("postgres: некорректная строка подключения: %w", err)}
+	}
+	return nil
+}
+
+func jdbcHasUser(jdbcURL string) bool {
+	parsed, err := parseJDBCURL(jdbcURL)
+	return err == nil && parsed.Query().Get("user") != ""
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
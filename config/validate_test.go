@@ -0,0 +1,169 @@
+package config
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// validConfig — конфигурация по умолчанию с минимально необходимыми параметрами базы
+func validConfig() *Config {
+	cfg := Default()
+	cfg.Postgres.Host = "db"
+	cfg.Postgres.Database = "tenders"
+	cfg.Postgres.Username = "app"
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   []string // поля, которые должны попасть в ошибку; пусто — ошибки нет
+	}{
+		{
+			name:   "значения по умолчанию",
+			modify: func(*Config) {},
+		},
+		{
+			name: "только строка подключения",
+			modify: func(c *Config) {
+				c.Postgres = PostgresConfig{Conn: "postgres://app@db:5432/tenders"}
+			},
+		},
+		{
+			name: "JDBC URL с пользователем в параметрах",
+			modify: func(c *Config) {
+				c.Postgres = PostgresConfig{JDBCURL: "jdbc:postgresql://db:5432/tenders?user=app", Port: 5432}
+			},
+		},
+		{
+			name: "параметры базы не заданы",
+			modify: func(c *Config) {
+				c.Postgres = PostgresConfig{}
+			},
+			want: []string{"postgres.host", "postgres.database", "postgres.port", "postgres.username"},
+		},
+		{
+			name: "некорректный JDBC URL",
+			modify: func(c *Config) {
+				c.Postgres = PostgresConfig{JDBCURL: "mysql://db/tenders", Username: "app"}
+			},
+			want: []string{"JDBC URL должен начинаться"},
+		},
+		{
+			name: "ошибки собираются все сразу",
+			modify: func(c *Config) {
+				c.Server.Address = "8080"
+				c.Server.ReadTimeout = 0
+				c.Log.Level = "verbose"
+				c.Attachments.Storage = "ftp"
+			},
+			want: []string{"server.address", "server.read_timeout", "log.level", "attachments.storage"},
+		},
+		{
+			name: "аренда ключа идемпотентности дольше его хранения",
+			modify: func(c *Config) {
+				c.Idempotency.LeaseTimeout = 2 * c.Idempotency.TTL
+			},
+			want: []string{"idempotency.lease_timeout"},
+		},
+		{
+			name: "S3 без адреса и бакета",
+			modify: func(c *Config) {
+				c.Attachments.Storage = "s3"
+			},
+			want: []string{"attachments.s3.endpoint", "attachments.s3.bucket"},
+		},
+		{
+			name: "мастер-ключ правильной длины",
+			modify: func(c *Config) {
+				c.Sealing.MasterKey = base64.StdEncoding.EncodeToString(make([]byte, 32))
+			},
+		},
+		{
+			name: "короткий мастер-ключ",
+			modify: func(c *Config) {
+				c.Sealing.MasterKey = base64.StdEncoding.EncodeToString(make([]byte, 16))
+			},
+			want: []string{"sealing.master_key"},
+		},
+		{
+			name: "мастер-ключ не в base64",
+			modify: func(c *Config) {
+				c.Sealing.MasterKey = "not base64!"
+			},
+			want: []string{"sealing.master_key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate() = nil, want errors for %v", tt.want)
+			}
+			for _, field := range tt.want {
+				if !strings.Contains(err.Error(), field) {
+					t.Errorf("Validate() error does not mention %q:\n%v", field, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	file := `
+server:
+  address: 127.0.0.1:8081
+postgres:
+  host: db
+  database: tenders
+  username: app
+log:
+  level: debug
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ConfigFileEnv, "")
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("HTTP_READ_TIMEOUT", "3s")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Server.Address != "127.0.0.1:8081" {
+		t.Errorf("Server.Address = %q, want value from file", cfg.Server.Address)
+	}
+	if cfg.Log.Level != "warn" {
+		t.Errorf("Log.Level = %q, want environment to override file", cfg.Log.Level)
+	}
+	if cfg.Server.ReadTimeout != 3*time.Second {
+		t.Errorf("Server.ReadTimeout = %v, want 3s from environment", cfg.Server.ReadTimeout)
+	}
+	if cfg.Server.WriteTimeout != Default().Server.WriteTimeout {
+		t.Errorf("Server.WriteTimeout = %v, want default", cfg.Server.WriteTimeout)
+	}
+
+	if err := os.WriteFile(path, []byte("server:\n  adress: 127.0.0.1:8081\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load() accepted unknown field in config file")
+	}
+}
@@ -0,0 +1,174 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"tender-service/internal/logger"
+	"tender-service/internal/tracing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// conn оборачивает пул соединений: каждый запрос получает спан трассировки
+// и запись в лог с контекстом вызвавшего его HTTP-запроса
+type conn struct {
+	pool *pgxpool.Pool
+}
+
+// querier — общие методы пула и транзакции, чтобы один запрос можно было выполнить и там, и там.
+// op — имя операции для лога и трассировки, обычно имя функции пакета database, выполняющей запрос.
+type querier interface {
+	Exec(ctx context.Context, op, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, op, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, op, sql string, args ...interface{}) pgx.Row
+}
+
+// pgxQuerier — те же методы пула и транзакции pgx без инструментирования
+type pgxQuerier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func (c *conn) Exec(ctx context.Context, op, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tracedExec(ctx, c.pool, op, sql, args...)
+}
+
+func (c *conn) Query(ctx context.Context, op, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tracedQuery(ctx, c.pool, op, sql, args...)
+}
+
+func (c *conn) QueryRow(ctx context.Context, op, sql string, args ...interface{}) pgx.Row {
+	return tracedQueryRow(ctx, c.pool, op, sql, args...)
+}
+
+// BeginFunc выполняет f в транзакции и фиксирует её, если f не вернула ошибку.
+// Транзакция получает свой спан, а запросы внутри неё — вложенные в него спаны и записи в лог.
+func (c *conn) BeginFunc(ctx context.Context, op string, f func(tx querier) error) error {
+	ctx, q := startQuery(ctx, op, "BEGIN")
+	err := c.pool.BeginFunc(ctx, func(t pgx.Tx) error {
+		return f(&tracedTx{tx: t, span: q.span})
+	})
+	q.finish(err)
+	return err
+}
+
+// tracedTx оборачивает транзакцию pgx так же, как conn — пул
+type tracedTx struct {
+	tx   pgx.Tx
+	span trace.Span
+}
+
+func (t *tracedTx) Exec(ctx context.Context, op, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tracedExec(trace.ContextWithSpan(ctx, t.span), t.tx, op, sql, args...)
+}
+
+func (t *tracedTx) Query(ctx context.Context, op, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tracedQuery(trace.ContextWithSpan(ctx, t.span), t.tx, op, sql, args...)
+}
+
+func (t *tracedTx) QueryRow(ctx context.Context, op, sql string, args ...interface{}) pgx.Row {
+	return tracedQueryRow(trace.ContextWithSpan(ctx, t.span), t.tx, op, sql, args...)
+}
+
+func tracedExec(ctx context.Context, db pgxQuerier, op, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, q := startQuery(ctx, op, sql)
+	tag, err := db.Exec(ctx, sql, args...)
+	q.finish(err)
+	return tag, err
+}
+
+func tracedQuery(ctx context.Context, db pgxQuerier, op, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, q := startQuery(ctx, op, sql)
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		q.finish(err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, query: q}, nil
+}
+
+func tracedQueryRow(ctx context.Context, db pgxQuerier, op, sql string, args ...interface{}) pgx.Row {
+	ctx, q := startQuery(ctx, op, sql)
+	return &tracedRow{row: db.QueryRow(ctx, sql, args...), query: q}
+}
+
+// query хранит состояние одного запроса к базе данных до его завершения
+type query struct {
+	ctx   context.Context
+	op    string
+	start time.Time
+	span  trace.Span
+	done  bool
+}
+
+func startQuery(ctx context.Context, op, sql string) (context.Context, *query) {
+	ctx, span := tracing.Tracer().Start(ctx, "database."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(strings.TrimSpace(sql)),
+			attribute.String("db.operation.name", op),
+		),
+	)
+	return ctx, &query{ctx: ctx, op: op, start: time.Now(), span: span}
+}
+
+func (q *query) finish(err error) {
+	if q.done {
+		return
+	}
+	q.done = true
+
+	log := logger.FromContext(q.ctx)
+	attrs := []slog.Attr{
+		slog.String("query", q.op),
+		slog.Duration("duration", time.Since(q.start)),
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		q.span.RecordError(err)
+		q.span.SetStatus(codes.Error, err.Error())
+		log.LogAttrs(q.ctx, slog.LevelError, "database query failed", append(attrs, slog.Any("error", err))...)
+	} else {
+		log.LogAttrs(q.ctx, slog.LevelDebug, "database query", attrs...)
+	}
+	q.span.End()
+}
+
+type tracedRow struct {
+	row   pgx.Row
+	query *query
+}
+
+func (r *tracedRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	r.query.finish(err)
+	return err
+}
+
+type tracedRows struct {
+	pgx.Rows
+	query *query
+}
+
+func (r *tracedRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.query.finish(r.Rows.Err())
+	return false
+}
+
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	r.query.finish(r.Rows.Err())
+}
@@ -2,14 +2,17 @@ package database
 
 import (
 	"context"
-	"log"
+	"errors"
 	"tender-service/internal/models"
 	"time"
 
 	"github.com/lib/pq"
 )
 
-func CheckUserOrganizationResponsibility(userID string, organizationID string) bool {
+// ErrVersionConflict возвращается условными обновлениями, если запись успел изменить другой запрос
+var ErrVersionConflict = errors.New("версия записи изменилась")
+
+func CheckUserOrganizationResponsibility(ctx context.Context, userID string, organizationID string) bool {
 	var exists bool
 
 	query := `
@@ -19,21 +22,21 @@ func CheckUserOrganizationResponsibility(userID string, organizationID string) b
 			WHERE org.user_id = $1 AND org.organization_id = $2
 		)
 	`
-	err := dbConn.QueryRow(context.Background(), query, userID, organizationID).Scan(&exists)
+	err := dbConn.QueryRow(ctx, "CheckUserOrganizationResponsibility", query, userID, organizationID).Scan(&exists)
 	if err != nil {
 		return false
 	}
 	return exists
 }
 
-func SaveTender(tender *models.Tender) error {
+func SaveTender(ctx context.Context, tender *models.Tender) error {
 	query := `
-		INSERT INTO tenders (id, name, description, service_type, status, organization_id, creator_username_id, version, created_at)
-		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+		INSERT INTO tenders (id, name, description, service_type, status, organization_id, creator_username_id, version, bid_deadline, sealed, sealed_key, visibility, created_at)
+		VALUES (COALESCE(NULLIF($11, '')::uuid, uuid_generate_v4()), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $12, CURRENT_TIMESTAMP)
 		RETURNING id, created_at
 	`
 
-	err := dbConn.QueryRow(context.Background(), query,
+	err := dbConn.QueryRow(ctx, "SaveTender", query,
 		tender.Name,
 		tender.Description,
 		tender.ServiceType,
@@ -41,29 +44,34 @@ func SaveTender(tender *models.Tender) error {
 		tender.OrganizationID,
 		tender.CreatorUsernameID,
 		tender.Version,
+		tender.BidDeadline,
+		tender.Sealed,
+		tender.SealedKey,
+		tender.ID,
+		tender.Visibility,
 	).Scan(&tender.ID, &tender.CreatedAt)
 
 	return err
 }
 
-func GetTendersResponse(serviceTypes []string, limit, offset int) ([]models.TenderResponse, error) {
+func GetTendersResponse(ctx context.Context, serviceTypes []string, limit, offset int) ([]models.TenderResponse, error) {
 	query := `
-		SELECT id, name, description, service_type, status, organization_id version, created_at 
+		SELECT id, name, description, service_type, status, organization_id, version, created_at, bid_deadline, sealed, opened_at, visibility
 		FROM tenders
+		WHERE visibility = $1
 	`
-	var args []interface{}
+	args := []interface{}{models.VisibilityPublic}
 	if len(serviceTypes) > 0 {
-		query += " WHERE service_type = ANY($1)"
+		query += " AND service_type = ANY($2)"
 		args = append(args, pq.Array(serviceTypes))
-		query += " ORDER BY name LIMIT $2 OFFSET $3"
+		query += " ORDER BY name LIMIT $3 OFFSET $4"
 	} else {
-		query += " ORDER BY name LIMIT $1 OFFSET $2"
+		query += " ORDER BY name LIMIT $2 OFFSET $3"
 	}
 	args = append(args, limit, offset)
 
-	rows, err := dbConn.Query(context.Background(), query, args...)
+	rows, err := dbConn.Query(ctx, "GetTendersResponse", query, args...)
 	if err != nil {
-		log.Printf("Ошибка выполнения запроса к базе данных: %v", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -71,35 +79,35 @@ func GetTendersResponse(serviceTypes []string, limit, offset int) ([]models.Tend
 	for rows.Next() {
 		var tender models.TenderResponse
 		var created_at time.Time
-		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &created_at)
+		var bidDeadline, openedAt *time.Time
+		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &created_at, &bidDeadline, &tender.Sealed, &openedAt, &tender.Visibility)
 		tender.CreatedAt = created_at.Format(time.RFC3339)
+		tender.BidDeadline = models.FormatTime(bidDeadline)
+		tender.OpenedAt = models.FormatTime(openedAt)
 		if err != nil {
-			log.Printf("Ошибка при обработке результата запроса: %v", err)
 			return nil, err
 		}
 		tenders = append(tenders, tender)
 	}
 
 	if err := rows.Err(); err != nil {
-		log.Printf("Ошибка при чтении строк: %v", err)
 		return nil, err
 	}
 
 	return tenders, nil
 }
 
-func GetTendersByUsername(usernameID string, limit, offset int) ([]models.TenderResponse, error) {
+func GetTendersByUsername(ctx context.Context, usernameID string, limit, offset int) ([]models.TenderResponse, error) {
 	query := `
-		SELECT id, name, description, service_type, status, version, created_at
+		SELECT id, name, description, service_type, status, organization_id, version, created_at, bid_deadline, sealed, opened_at, visibility
 		FROM tenders
 		WHERE creator_username_id = $1
 		ORDER BY name
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := dbConn.Query(context.Background(), query, usernameID, limit, offset)
+	rows, err := dbConn.Query(ctx, "GetTendersByUsername", query, usernameID, limit, offset)
 	if err != nil {
-		log.Printf("Ошибка выполнения запроса к базе данных: %v", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -108,17 +116,18 @@ func GetTendersByUsername(usernameID string, limit, offset int) ([]models.Tender
 	for rows.Next() {
 		var tender models.TenderResponse
 		var createdAt time.Time
-		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.Version, &createdAt)
+		var bidDeadline, openedAt *time.Time
+		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &createdAt, &bidDeadline, &tender.Sealed, &openedAt, &tender.Visibility)
 		tender.CreatedAt = createdAt.Format(time.RFC3339)
+		tender.BidDeadline = models.FormatTime(bidDeadline)
+		tender.OpenedAt = models.FormatTime(openedAt)
 		if err != nil {
-			log.Printf("Ошибка при обработке результата запроса: %v", err)
 			return nil, err
 		}
 		tenders = append(tenders, tender)
 	}
 
 	if err := rows.Err(); err != nil {
-		log.Printf("Ошибка при чтении строк: %v", err)
 		return nil, err
 	}
 
@@ -126,14 +135,15 @@ func GetTendersByUsername(usernameID string, limit, offset int) ([]models.Tender
 }
 
 // версия 1
-func GetTenderByID(tenderID string) (*models.Tender, error) {
+func GetTenderByID(ctx context.Context, tenderID string) (*models.Tender, error) {
 	var tender models.Tender
 	query := `
-		SELECT id, name, description, service_type, status, organization_id, creator_username_id, version, created_at
+		SELECT id, name, description, service_type, status, organization_id, creator_username_id, version, created_at, bid_deadline,
+		       sealed, sealed_key, opened_at, visibility
 		FROM tenders
 		WHERE id = $1
 	`
-	err := dbConn.QueryRow(context.Background(), query, tenderID).Scan(
+	err := dbConn.QueryRow(ctx, "GetTenderByID", query, tenderID).Scan(
 		&tender.ID,
 		&tender.Name,
 		&tender.Description,
@@ -143,6 +153,11 @@ func GetTenderByID(tenderID string) (*models.Tender, error) {
 		&tender.CreatorUsernameID,
 		&tender.Version,
 		&tender.CreatedAt,
+		&tender.BidDeadline,
+		&tender.Sealed,
+		&tender.SealedKey,
+		&tender.OpenedAt,
+		&tender.Visibility,
 	)
 	if err != nil {
 		return nil, err
@@ -151,14 +166,16 @@ func GetTenderByID(tenderID string) (*models.Tender, error) {
 	return &tender, nil
 }
 
-func UpdateTender(tender *models.Tender) error {
+// UpdateTender сохраняет тендер, только если в базе всё ещё версия expectedVersion;
+// иначе возвращает ErrVersionConflict
+func UpdateTender(ctx context.Context, tender *models.Tender, expectedVersion int) error {
 	query := `
 		UPDATE tenders 
 		SET name = $1, description = $2, service_type = $3, status = $4, 
-		    organization_id = $5, creator_username_id = $6, version = $7
-		WHERE id = $8
+		    organization_id = $5, creator_username_id = $6, version = $7, bid_deadline = $8
+		WHERE id = $9 AND version = $10
 	`
-	_, err := dbConn.Exec(context.Background(), query,
+	tag, err := dbConn.Exec(ctx, "UpdateTender", query,
 		tender.Name,
 		tender.Description,
 		tender.ServiceType,
@@ -166,17 +183,25 @@ func UpdateTender(tender *models.Tender) error {
 		tender.OrganizationID,
 		tender.CreatorUsernameID,
 		tender.Version,
+		tender.BidDeadline,
 		tender.ID,
+		expectedVersion,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrVersionConflict
+	}
+	return nil
 }
 
-func SaveTenderHistory(tenderHistory *models.TenderHistory) error {
+func SaveTenderHistory(ctx context.Context, tenderHistory *models.TenderHistory) error {
 	query := `
 		INSERT INTO tender_history (id, tender_id, name, description, service_type, version)
 		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5)
 	`
-	_, err := dbConn.Exec(context.Background(), query,
+	_, err := dbConn.Exec(ctx, "SaveTenderHistory", query,
 		tenderHistory.TenderID,
 		tenderHistory.Name,
 		tenderHistory.Description,
@@ -186,31 +211,32 @@ func SaveTenderHistory(tenderHistory *models.TenderHistory) error {
 	return err
 }
 
-func GetBidHistoryByVersion(bidID string, version int) (*models.BidHistory, error) {
+func GetBidHistoryByVersion(ctx context.Context, bidID string, version int) (*models.BidHistory, error) {
 	var bidHistory models.BidHistory
 	query := `
-		SELECT id, bid_id, name, description, version
+		SELECT id, bid_id, name, description, version, sealed_payload
 		FROM bid_history
 		WHERE bid_id = $1 AND version = $2
 	`
-	err := dbConn.QueryRow(context.Background(), query, bidID, version).Scan(
+	err := dbConn.QueryRow(ctx, "GetBidHistoryByVersion", query, bidID, version).Scan(
 		&bidHistory.ID,
 		&bidHistory.BidID,
 		&bidHistory.Name,
 		&bidHistory.Description,
 		&bidHistory.Version,
+		&bidHistory.SealedPayload,
 	)
 	return &bidHistory, err
 }
 
-func GetTenderHistoryByVersion(tenderID string, version int) (*models.TenderHistory, error) {
+func GetTenderHistoryByVersion(ctx context.Context, tenderID string, version int) (*models.TenderHistory, error) {
 	var tenderHistory models.TenderHistory
 	query := `
 		SELECT id, tender_id, name, description, service_type, version
 		FROM tender_history
 		WHERE tender_id = $1 AND version = $2
 	`
-	err := dbConn.QueryRow(context.Background(), query, tenderID, version).Scan(
+	err := dbConn.QueryRow(ctx, "GetTenderHistoryByVersion", query, tenderID, version).Scan(
 		&tenderHistory.ID,
 		&tenderHistory.TenderID,
 		&tenderHistory.Name,
@@ -221,7 +247,7 @@ func GetTenderHistoryByVersion(tenderID string, version int) (*models.TenderHist
 	return &tenderHistory, err
 }
 
-// func GetUserByID(userID string) (*models.User, error) {
+// func GetUserByID(ctx context.Context, userID string) (*models.User, error) {
 // 	var user models.User
 
 // 	query := `
@@ -231,7 +257,7 @@ func GetTenderHistoryByVersion(tenderID string, version int) (*models.TenderHist
 // 	`
 
 // 	// Выполняем запрос к базе данных
-// 	err := dbConn.QueryRow(context.Background(), query, userID).Scan(
+// 	err := dbConn.QueryRow(ctx, "GetTenderHistoryByVersion", query, userID).Scan(
 // 		&user.ID,
 // 		&user.Username,
 // 		&user.FirstName,
@@ -245,14 +271,15 @@ func GetTenderHistoryByVersion(tenderID string, version int) (*models.TenderHist
 
 // 	return &user, nil
 // }
-func GetApprovedDecisionsByBidID(bidID string) ([]models.UserDecision, error) {
+// GetApprovedDecisionsByBidID возвращает одобрения версии предложения version; lotID ограничивает их одним лотом
+func GetApprovedDecisionsByBidID(ctx context.Context, bidID, lotID string, version int) ([]models.UserDecision, error) {
 	decisions := []models.UserDecision{}
 	query := `
         SELECT id, user_id, bid_id, decision, created_at
         FROM bid_decisions
-        WHERE bid_id = $1 AND decision = $2
+        WHERE bid_id = $1 AND decision = $2 AND lot_id IS NOT DISTINCT FROM NULLIF($3, '')::uuid AND bid_version = $4 AND invalidated_at IS NULL
     `
-	rows, err := dbConn.Query(context.Background(), query, bidID, "Approved")
+	rows, err := dbConn.Query(ctx, "GetApprovedDecisionsByBidID", query, bidID, "Approved", lotID, version)
 	if err != nil {
 		return nil, err
 	}
@@ -273,4 +300,30 @@ func GetApprovedDecisionsByBidID(bidID string) ([]models.UserDecision, error) {
 	}
 
 	return decisions, nil
-}
\ No newline at end of file
+}
+func CountTendersByStatusAndServiceType(ctx context.Context) ([]models.TenderCount, error) {
+	query := `
+		SELECT status, service_type, COUNT(*)
+		FROM tenders
+		GROUP BY status, service_type
+	`
+	rows, err := dbConn.Query(ctx, "CountTendersByStatusAndServiceType", query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := []models.TenderCount{}
+	for rows.Next() {
+		var count models.TenderCount
+		if err := rows.Scan(&count.Status, &count.ServiceType, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func SaveAttachment(ctx context.Context, attachment *models.Attachment) error {
+	query := `
+		INSERT INTO attachments (id, tender_id, bid_id, name, content_type, size, checksum, storage_key, version, uploaded_by, created_at)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP)
+		RETURNING created_at
+	`
+	return dbConn.QueryRow(ctx, "SaveAttachment", query,
+		attachment.ID,
+		attachment.TenderID,
+		attachment.BidID,
+		attachment.Name,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.Checksum,
+		attachment.StorageKey,
+		attachment.Version,
+		attachment.UploadedBy,
+	).Scan(&attachment.CreatedAt)
+}
+
+const attachmentColumns = `
+	id, COALESCE(tender_id::text, ''), COALESCE(bid_id::text, ''), name, content_type,
+	size, checksum, storage_key, version, uploaded_by, created_at
+`
+
+func scanAttachment(row pgx.Row, attachment *models.Attachment) error {
+	return row.Scan(
+		&attachment.ID,
+		&attachment.TenderID,
+		&attachment.BidID,
+		&attachment.Name,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.Checksum,
+		&attachment.StorageKey,
+		&attachment.Version,
+		&attachment.UploadedBy,
+		&attachment.CreatedAt,
+	)
+}
+
+// GetAttachments возвращает вложения тендера (bidID пуст) или предложения (tenderID пуст) в порядке загрузки
+func GetAttachments(ctx context.Context, tenderID, bidID string) ([]models.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE tender_id IS NOT DISTINCT FROM NULLIF($1, '')::uuid
+		  AND bid_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid
+		ORDER BY created_at, name
+	`
+	rows, err := dbConn.Query(ctx, "GetAttachments", query, tenderID, bidID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := []models.Attachment{}
+	for rows.Next() {
+		var attachment models.Attachment
+		if err := scanAttachment(rows, &attachment); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func GetAttachmentByID(ctx context.Context, attachmentID string) (*models.Attachment, error) {
+	var attachment models.Attachment
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE id = $1`
+	if err := scanAttachment(dbConn.QueryRow(ctx, "GetAttachmentByID", query, attachmentID), &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func DeleteAttachment(ctx context.Context, attachmentID string) error {
+	_, err := dbConn.Exec(ctx, "DeleteAttachment", `DELETE FROM attachments WHERE id = $1`, attachmentID)
+	return err
+}
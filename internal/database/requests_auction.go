@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	// ErrAuctionNotRunning возвращается SaveAuctionPrice, если торги ещё не начались или уже завершены
+	ErrAuctionNotRunning = errors.New("торги не идут")
+	// ErrPriceNotLower возвращается SaveAuctionPrice, если цена снижена меньше чем на шаг аукциона
+	ErrPriceNotLower = errors.New("цена снижена меньше чем на шаг")
+)
+
+const auctionColumns = `
+	tender_id, starts_at, ends_at, min_decrement::float8, extension_seconds, closed_at, COALESCE(awarded_bid_id::text, '')
+`
+
+func scanAuction(row pgx.Row, auction *models.Auction) error {
+	return row.Scan(
+		&auction.TenderID,
+		&auction.StartsAt,
+		&auction.EndsAt,
+		&auction.MinDecrement,
+		&auction.Extension,
+		&auction.ClosedAt,
+		&auction.AwardedBidID,
+	)
+}
+
+func SaveAuction(ctx context.Context, auction *models.Auction) error {
+	query := `
+		INSERT INTO tender_auctions (tender_id, starts_at, ends_at, min_decrement, extension_seconds)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := dbConn.Exec(ctx, "SaveAuction", query, auction.TenderID, auction.StartsAt, auction.EndsAt, auction.MinDecrement, auction.Extension)
+	return err
+}
+
+// GetAuctionByTenderID возвращает аукцион тендера или pgx.ErrNoRows, если тендер проводится без торгов
+func GetAuctionByTenderID(ctx context.Context, tenderID string) (*models.Auction, error) {
+	var auction models.Auction
+	query := `SELECT ` + auctionColumns + ` FROM tender_auctions WHERE tender_id = $1`
+	if err := scanAuction(dbConn.QueryRow(ctx, "GetAuctionByTenderID", query, tenderID), &auction); err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+// SaveAuctionPrice сохраняет новую цену предложения на торгах. Торги блокируются до конца транзакции,
+// так что цена не пройдёт после закрытия, а ставка меньше чем за extension_seconds до конца
+// продлевает торги на это время. Возвращает аукцион с учётом продления.
+func SaveAuctionPrice(ctx context.Context, tenderID, bidID string, price float64) (*models.Auction, error) {
+	var auction models.Auction
+	err := dbConn.BeginFunc(ctx, "SaveAuctionPrice", func(tx querier) error {
+		var running bool
+		query := `
+			SELECT ` + auctionColumns + `,
+			       closed_at IS NULL AND starts_at <= CURRENT_TIMESTAMP AND CURRENT_TIMESTAMP < ends_at
+			FROM tender_auctions
+			WHERE tender_id = $1
+			FOR UPDATE
+		`
+		row := tx.QueryRow(ctx, "SaveAuctionPrice", query, tenderID)
+		if err := row.Scan(&auction.TenderID, &auction.StartsAt, &auction.EndsAt, &auction.MinDecrement,
+			&auction.Extension, &auction.ClosedAt, &auction.AwardedBidID, &running); err != nil {
+			return err
+		}
+		if !running {
+			return ErrAuctionNotRunning
+		}
+
+		var tooHigh bool
+		query = `
+			SELECT EXISTS (
+				SELECT 1 FROM auction_bids
+				WHERE bid_id = $1 AND $2::numeric > price - $3::numeric
+			)
+		`
+		if err := tx.QueryRow(ctx, "SaveAuctionPrice", query, bidID, price, auction.MinDecrement).Scan(&tooHigh); err != nil {
+			return err
+		}
+		if tooHigh {
+			return ErrPriceNotLower
+		}
+
+		query = `
+			INSERT INTO auction_bids (bid_id, tender_id, price, updated_at)
+			VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+			ON CONFLICT (bid_id) DO UPDATE SET price = EXCLUDED.price, updated_at = EXCLUDED.updated_at
+		`
+		if _, err := tx.Exec(ctx, "SaveAuctionPrice", query, bidID, tenderID, price); err != nil {
+			return err
+		}
+
+		query = `
+			UPDATE tender_auctions
+			SET ends_at = CURRENT_TIMESTAMP + make_interval(secs => extension_seconds)
+			WHERE tender_id = $1 AND ends_at < CURRENT_TIMESTAMP + make_interval(secs => extension_seconds)
+			RETURNING ends_at
+		`
+		err := tx.QueryRow(ctx, "SaveAuctionPrice", query, tenderID).Scan(&auction.EndsAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+// GetAuctionBids возвращает цены опубликованных и закрытых предложений на торгах
+// от лучшей к худшей; при равной цене выше та, что названа раньше
+func GetAuctionBids(ctx context.Context, tenderID string) ([]models.AuctionBid, error) {
+	query := `
+		SELECT ab.bid_id, ab.tender_id, b.author_type, b.author_id, ab.price::float8, ab.updated_at
+		FROM auction_bids AS ab
+		JOIN bids AS b ON b.id = ab.bid_id
+		WHERE ab.tender_id = $1 AND b.status IN ($2, $3)
+		ORDER BY ab.price, ab.updated_at
+	`
+	rows, err := dbConn.Query(ctx, "GetAuctionBids", query, tenderID, models.Published, models.Closed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bids []models.AuctionBid
+	for rows.Next() {
+		var bid models.AuctionBid
+		if err := rows.Scan(&bid.BidID, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Price, &bid.UpdatedAt); err != nil {
+			return nil, err
+		}
+		bids = append(bids, bid)
+	}
+	return bids, rows.Err()
+}
+
+// GetDueAuctionTenderIDs возвращает до limit тендеров, торги по которым истекли, но ещё не закрыты
+func GetDueAuctionTenderIDs(ctx context.Context, limit int) ([]string, error) {
+	query := `
+		SELECT tender_id FROM tender_auctions
+		WHERE closed_at IS NULL AND ends_at <= CURRENT_TIMESTAMP
+		ORDER BY ends_at
+		LIMIT $1
+	`
+	rows, err := dbConn.Query(ctx, "GetDueAuctionTenderIDs", query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// CloseAuction закрывает истёкшие торги одной транзакцией: присуждает тендер опубликованному предложению
+// с наименьшей ценой, закрывает ожидающие решения предложения тендера (победителя — одобренным,
+// остальные — отклонёнными из-за конфликта), создаёт по победителю договор и записывает событие в журнал.
+// Возвращает изменённые предложения; если торги не истекли или уже закрыты, ничего не меняет и возвращает nil.
+func CloseAuction(ctx context.Context, tenderID string, event *models.AuditEvent) (*models.Auction, []models.Bid, error) {
+	var auction *models.Auction
+	var settled []models.Bid
+	err := dbConn.BeginFunc(ctx, "CloseAuction", func(tx querier) error {
+		// Сначала торги блокируются, и только затем выбирается победитель: отдельный запрос видит
+		// цены, сохранённые транзакциями, которые держали блокировку до этого
+		var due bool
+		query := `
+			SELECT closed_at IS NULL AND ends_at <= CURRENT_TIMESTAMP
+			FROM tender_auctions
+			WHERE tender_id = $1
+			FOR UPDATE
+		`
+		if err := tx.QueryRow(ctx, "CloseAuction", query, tenderID).Scan(&due); err != nil || !due {
+			return err
+		}
+
+		var winner models.AuctionBid
+		query = `
+			SELECT ab.bid_id, ab.price::float8
+			FROM auction_bids AS ab
+			JOIN bids AS b ON b.id = ab.bid_id
+			WHERE ab.tender_id = $1 AND b.status = $2 AND b.coordination = $3
+			ORDER BY ab.price, ab.updated_at
+			LIMIT 1
+		`
+		err := tx.QueryRow(ctx, "CloseAuction", query, tenderID, models.Published, models.Expectation).Scan(&winner.BidID, &winner.Price)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		auction = &models.Auction{}
+		query = `
+			UPDATE tender_auctions SET closed_at = CURRENT_TIMESTAMP, awarded_bid_id = NULLIF($2, '')::uuid
+			WHERE tender_id = $1
+			RETURNING ` + auctionColumns
+		if err := scanAuction(tx.QueryRow(ctx, "CloseAuction", query, tenderID, winner.BidID), auction); err != nil {
+			return err
+		}
+
+		if winner.BidID != "" {
+			query = `
+				UPDATE bids
+				SET status = $3, coordination = CASE WHEN id = $2 THEN $4 ELSE $5 END
+				WHERE tender_id = $1 AND coordination = $6
+				RETURNING id, status, tender_id, author_type, author_id, version, coordination
+			`
+			rows, err := tx.Query(ctx, "CloseAuction", query, tenderID, winner.BidID, models.Closed, models.Approved, models.RejectedByConflict, models.Expectation)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var bid models.Bid
+				if err := rows.Scan(&bid.ID, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.Сoordination); err != nil {
+					return err
+				}
+				settled = append(settled, bid)
+			}
+			if err := rows.Err(); err != nil {
+				return err
+			}
+			rows.Close()
+
+			award := &models.Award{TenderID: tenderID, BidID: winner.BidID, Price: &winner.Price}
+			for _, bid := range settled {
+				if bid.ID == winner.BidID {
+					award.BidVersion = bid.Version
+				}
+			}
+			if err := insertAward(ctx, tx, award); err != nil {
+				return err
+			}
+		}
+
+		details := map[string]interface{}{"bids": len(settled)}
+		if winner.BidID != "" {
+			details["awardedBidId"] = winner.BidID
+			details["price"] = winner.Price
+		}
+		event.Details, err = json.Marshal(details)
+		if err != nil {
+			return err
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return auction, settled, nil
+}
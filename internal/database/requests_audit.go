@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+)
+
+// saveAuditEvent записывает событие в журнал; вызывается в транзакции вместе с изменением, которое оно описывает
+func saveAuditEvent(ctx context.Context, db querier, event *models.AuditEvent) error {
+	query := `
+		INSERT INTO audit_events (tender_id, entity_type, entity_id, action, actor_id, details)
+		VALUES (NULLIF($1, '')::uuid, $2, $3, $4, NULLIF($5, '')::uuid, $6)
+		RETURNING id, created_at
+	`
+	return db.QueryRow(ctx, "saveAuditEvent", query, event.TenderID, event.EntityType, event.EntityID, event.Action, event.ActorID, []byte(event.Details)).
+		Scan(&event.ID, &event.CreatedAt)
+}
+
+// GetAuditEvents возвращает журнал событий тендера в хронологическом порядке
+func GetAuditEvents(ctx context.Context, tenderID string, limit, offset int) ([]models.AuditEvent, error) {
+	query := `
+		SELECT id, COALESCE(tender_id::text, ''), entity_type, entity_id, action, COALESCE(actor_id::text, ''),
+		       details, created_at
+		FROM audit_events
+		WHERE tender_id = $1
+		ORDER BY created_at, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := dbConn.Query(ctx, "GetAuditEvents", query, tenderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []models.AuditEvent{}
+	for rows.Next() {
+		var event models.AuditEvent
+		var details []byte
+		if err := rows.Scan(&event.ID, &event.TenderID, &event.EntityType, &event.EntityID, &event.Action, &event.ActorID,
+			&details, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		event.Details = details
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
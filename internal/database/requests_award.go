@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+// ErrAwardState возвращается, если статус договора изменился, пока принималось решение
+var ErrAwardState = errors.New("статус договора уже изменён")
+
+const awardColumns = `
+	id, tender_id, COALESCE(lot_id::text, ''), bid_id, bid_version, price::float8, status, status_reason, created_at, updated_at
+`
+
+func scanAward(row pgx.Row, award *models.Award) error {
+	return row.Scan(
+		&award.ID,
+		&award.TenderID,
+		&award.LotID,
+		&award.BidID,
+		&award.BidVersion,
+		&award.Price,
+		&award.Status,
+		&award.StatusReason,
+		&award.CreatedAt,
+		&award.UpdatedAt,
+	)
+}
+
+// CreateAward сохраняет присуждение тендера или лота. Повторное присуждение того же тендера (лота)
+// ничего не меняет: договор по нему уже есть.
+func CreateAward(ctx context.Context, award *models.Award) error {
+	return insertAward(ctx, dbConn, award)
+}
+
+func insertAward(ctx context.Context, db querier, award *models.Award) error {
+	query := `
+		INSERT INTO awards (tender_id, lot_id, bid_id, bid_version, price, status)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4, $5, $6)
+		ON CONFLICT (tender_id, COALESCE(lot_id, tender_id)) DO NOTHING
+		RETURNING id, status, created_at, updated_at
+	`
+	err := db.QueryRow(ctx, "insertAward", query, award.TenderID, award.LotID, award.BidID, award.BidVersion, award.Price, models.AwardPendingSignature).
+		Scan(&award.ID, &award.Status, &award.CreatedAt, &award.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+func GetAwardByID(ctx context.Context, awardID string) (*models.Award, error) {
+	var award models.Award
+	query := `SELECT ` + awardColumns + ` FROM awards WHERE id = $1`
+	if err := scanAward(dbConn.QueryRow(ctx, "GetAwardByID", query, awardID), &award); err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// GetAwardsByTenderID возвращает присуждения по тендеру в порядке создания.
+// Если authorID задан, только по предложениям этого автора.
+func GetAwardsByTenderID(ctx context.Context, tenderID, authorID string, limit, offset int) ([]models.Award, error) {
+	query := `
+		SELECT ` + awardColumns + `
+		FROM awards
+		WHERE tender_id = $1
+		  AND ($2 = '' OR bid_id IN (SELECT id FROM bids WHERE author_id::text = $2))
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := dbConn.Query(ctx, "GetAwardsByTenderID", query, tenderID, authorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	awards := []models.Award{}
+	for rows.Next() {
+		var award models.Award
+		if err := scanAward(rows, &award); err != nil {
+			return nil, err
+		}
+		awards = append(awards, award)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return awards, nil
+}
+
+// UpdateAwardStatus переводит договор из статуса from в award.Status одной транзакцией вместе
+// с отзывом о поставщике (если он передан) и записью в журнал событий тендера.
+// Если статус договора уже не from, возвращает ErrAwardState.
+func UpdateAwardStatus(ctx context.Context, award *models.Award, from models.AwardStatus, feedback *models.Feedback, event *models.AuditEvent) error {
+	return dbConn.BeginFunc(ctx, "UpdateAwardStatus", func(tx querier) error {
+		query := `
+			UPDATE awards SET status = $3, status_reason = $4, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND status = $2
+			RETURNING updated_at
+		`
+		err := tx.QueryRow(ctx, "UpdateAwardStatus", query, award.ID, from, award.Status, award.StatusReason).Scan(&award.UpdatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAwardState
+		}
+		if err != nil {
+			return err
+		}
+		if feedback != nil {
+			if err := saveFeedback(ctx, tx, feedback); err != nil {
+				return err
+			}
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+}
+
+func SaveAwardMilestone(ctx context.Context, milestone *models.AwardMilestone) error {
+	query := `
+		INSERT INTO award_milestones (award_id, name, description, due_date)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	return dbConn.QueryRow(ctx, "SaveAwardMilestone", query, milestone.AwardID, milestone.Name, milestone.Description, milestone.DueDate).
+		Scan(&milestone.ID, &milestone.CreatedAt)
+}
+
+// GetAwardMilestones возвращает этапы договоров awardIDs по возрастанию срока
+func GetAwardMilestones(ctx context.Context, awardIDs []string) ([]models.AwardMilestone, error) {
+	query := `
+		SELECT id, award_id, name, description, due_date, completed_at, created_at
+		FROM award_milestones
+		WHERE award_id = ANY($1)
+		ORDER BY due_date, created_at, id
+	`
+	rows, err := dbConn.Query(ctx, "GetAwardMilestones", query, pq.Array(awardIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	milestones := []models.AwardMilestone{}
+	for rows.Next() {
+		var milestone models.AwardMilestone
+		err := rows.Scan(&milestone.ID, &milestone.AwardID, &milestone.Name, &milestone.Description,
+			&milestone.DueDate, &milestone.CompletedAt, &milestone.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		milestones = append(milestones, milestone)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return milestones, nil
+}
+
+// CompleteAwardMilestone отмечает этап договора выполненным. Возвращает pgx.ErrNoRows, если этапа нет,
+// и ErrAwardState, если он уже выполнен.
+func CompleteAwardMilestone(ctx context.Context, awardID, milestoneID string) error {
+	query := `
+		UPDATE award_milestones
+		SET completed_at = COALESCE(completed_at, CURRENT_TIMESTAMP)
+		WHERE id = $1 AND award_id = $2
+		RETURNING completed_at = CURRENT_TIMESTAMP
+	`
+	var completed bool
+	if err := dbConn.QueryRow(ctx, "CompleteAwardMilestone", query, milestoneID, awardID).Scan(&completed); err != nil {
+		return err
+	}
+	if !completed {
+		return ErrAwardState
+	}
+	return nil
+}
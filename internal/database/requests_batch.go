@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+// Запросы этого файла загружают данные сразу для набора ID и используются загрузчиками GraphQL,
+// чтобы вложенные поля не порождали по запросу на каждую строку.
+
+func GetTendersByIDs(ctx context.Context, tenderIDs []string) ([]models.TenderResponse, error) {
+	query := `
+		SELECT id, name, description, service_type, status, organization_id, version, created_at, bid_deadline, sealed, opened_at, visibility
+		FROM tenders
+		WHERE id = ANY($1)
+	`
+	rows, err := dbConn.Query(ctx, "GetTendersByIDs", query, pq.Array(tenderIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenders := []models.TenderResponse{}
+	for rows.Next() {
+		var tender models.TenderResponse
+		var createdAt time.Time
+		var bidDeadline, openedAt *time.Time
+		if err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &createdAt, &bidDeadline, &tender.Sealed, &openedAt, &tender.Visibility); err != nil {
+			return nil, err
+		}
+		tender.CreatedAt = createdAt.Format(time.RFC3339)
+		tender.BidDeadline = models.FormatTime(bidDeadline)
+		tender.OpenedAt = models.FormatTime(openedAt)
+		tenders = append(tenders, tender)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tenders, nil
+}
+
+func GetBidsByIDs(ctx context.Context, bidIDs []string) ([]models.BidResponse, error) {
+	query := `
+		SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at, sealed_payload IS NOT NULL
+		FROM bids
+		WHERE id = ANY($1)
+	`
+	rows, err := dbConn.Query(ctx, "GetBidsByIDs", query, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanBidResponses(rows)
+}
+
+// GetBidsByTenderIDs возвращает для каждого тендера страницу предложений,
+// упорядоченных по названию, как GetBidsByTenderID
+func GetBidsByTenderIDs(ctx context.Context, tenderIDs []string, limit, offset int) ([]models.BidResponse, error) {
+	query := `
+		SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at, sealed_payload IS NOT NULL
+		FROM (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY tender_id ORDER BY name) AS position
+			FROM bids
+			WHERE tender_id = ANY($1)
+		) AS numbered
+		WHERE position > $3 AND position <= $2 + $3
+		ORDER BY tender_id, position
+	`
+	rows, err := dbConn.Query(ctx, "GetBidsByTenderIDs", query, pq.Array(tenderIDs), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanBidResponses(rows)
+}
+
+func scanBidResponses(rows pgx.Rows) ([]models.BidResponse, error) {
+	bids := []models.BidResponse{}
+	for rows.Next() {
+		var bid models.BidResponse
+		var createdAt time.Time
+		if err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &createdAt, &bid.Sealed); err != nil {
+			return nil, err
+		}
+		bid.CreatedAt = createdAt.Format(time.RFC3339)
+		bids = append(bids, bid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bids, nil
+}
+
+func GetDecisionsByBidIDs(ctx context.Context, bidIDs []string) ([]models.UserDecision, error) {
+	query := `
+		SELECT id, user_id, bid_id, COALESCE(lot_id::text, ''), COALESCE(bid_version, 0), decision, comment, created_at
+		FROM bid_decisions
+		WHERE bid_id = ANY($1) AND invalidated_at IS NULL
+		ORDER BY created_at
+	`
+	rows, err := dbConn.Query(ctx, "GetDecisionsByBidIDs", query, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decisions := []models.UserDecision{}
+	for rows.Next() {
+		var decision models.UserDecision
+		if err := rows.Scan(&decision.ID, &decision.UserID, &decision.BidID, &decision.LotID, &decision.BidVersion, &decision.Decision, &decision.Comment, &decision.Created_at); err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+func GetFeedbackByBidIDs(ctx context.Context, bidIDs []string) ([]models.Feedback, error) {
+	query := `
+		SELECT id, user_id, bid_id, bid_feedback, quality, timeliness, communication, created_at
+		FROM feedback
+		WHERE bid_id = ANY($1) AND hidden_at IS NULL
+		ORDER BY created_at
+	`
+	rows, err := dbConn.Query(ctx, "GetFeedbackByBidIDs", query, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	feedbacks := []models.Feedback{}
+	for rows.Next() {
+		var feedback models.Feedback
+		var quality, timeliness, communication *int
+		if err := rows.Scan(&feedback.ID, &feedback.UserID, &feedback.BidID, &feedback.BidFeedback, &quality, &timeliness, &communication, &feedback.CreatedAt); err != nil {
+			return nil, err
+		}
+		feedback.Ratings = feedbackRatings(quality, timeliness, communication)
+		feedbacks = append(feedbacks, feedback)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return feedbacks, nil
+}
+
+func GetUsersByIDs(ctx context.Context, userIDs []string) ([]models.User, error) {
+	query := `
+		SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at
+		FROM employee
+		WHERE id = ANY($1)
+	`
+	rows, err := dbConn.Query(ctx, "GetUsersByIDs", query, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.FirstName, &user.LastName, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func GetOrganizationsByIDs(ctx context.Context, organizationIDs []string) ([]models.Organization, error) {
+	query := `
+		SELECT id, name, COALESCE(description, ''), COALESCE(type::text, ''), created_at, updated_at
+		FROM organization
+		WHERE id = ANY($1)
+	`
+	rows, err := dbConn.Query(ctx, "GetOrganizationsByIDs", query, pq.Array(organizationIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	organizations := []models.Organization{}
+	for rows.Next() {
+		var organization models.Organization
+		if err := rows.Scan(&organization.ID, &organization.Name, &organization.Description, &organization.Type, &organization.CreatedAt, &organization.UpdatedAt); err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, organization)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+// GetResponsibleOrganizationIDs возвращает те из organizationIDs, за которые отвечает пользователь
+func GetResponsibleOrganizationIDs(ctx context.Context, userID string, organizationIDs []string) ([]string, error) {
+	query := `
+		SELECT DISTINCT organization_id
+		FROM organization_responsible
+		WHERE user_id = $1 AND organization_id = ANY($2)
+	`
+	rows, err := dbConn.Query(ctx, "GetResponsibleOrganizationIDs", query, userID, pq.Array(organizationIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetManagedBidIDs возвращает те из bidIDs, за организацию тендера которых отвечает пользователь
+func GetManagedBidIDs(ctx context.Context, userID string, bidIDs []string) ([]string, error) {
+	query := `
+		SELECT DISTINCT b.id
+		FROM bids b
+		JOIN tenders t ON t.id = b.tender_id
+		JOIN organization_responsible r ON r.organization_id = t.organization_id
+		WHERE r.user_id = $1 AND b.id = ANY($2)
+	`
+	rows, err := dbConn.Query(ctx, "GetManagedBidIDs", query, userID, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
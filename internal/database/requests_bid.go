@@ -2,32 +2,39 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"tender-service/internal/models"
 	"time"
+
+	"github.com/jackc/pgx/v4"
 )
 
-func SaveBid(bid *models.Bid) error {
+func SaveBid(ctx context.Context, bid *models.Bid) error {
+	return saveBid(ctx, dbConn, bid)
+}
+
+func saveBid(ctx context.Context, db querier, bid *models.Bid) error {
 	query := `
-        INSERT INTO bids (id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at)
-        VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP)
+        INSERT INTO bids (id, name, description, status, tender_id, author_type, author_id, version, coordination, sealed_payload, created_at)
+        VALUES (COALESCE(NULLIF($10, '')::uuid, uuid_generate_v4()), $1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP)
         RETURNING id, created_at
     `
-	err := dbConn.QueryRow(context.Background(), query, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.AuthorType, bid.AuthorID, bid.Version, bid.Сoordination).
+	err := db.QueryRow(ctx, "saveBid", query, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.AuthorType, bid.AuthorID, bid.Version, bid.Сoordination, bid.SealedPayload, bid.ID).
 		Scan(&bid.ID, &bid.CreatedAt)
 
 	return err
 }
 
 // версия 1
-func GetBidByID(bidID string) (*models.Bid, error) {
+func GetBidByID(ctx context.Context, bidID string) (*models.Bid, error) {
 	var bid models.Bid
 	query := `
-		SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at
+		SELECT id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at, sealed_payload
 		FROM bids
 		WHERE id = $1
 	`
-	err := dbConn.QueryRow(context.Background(), query, bidID).Scan(
+	err := dbConn.QueryRow(ctx, "GetBidByID", query, bidID).Scan(
 		&bid.ID,
 		&bid.Name,
 		&bid.Description,
@@ -36,7 +43,9 @@ func GetBidByID(bidID string) (*models.Bid, error) {
 		&bid.AuthorType,
 		&bid.AuthorID,
 		&bid.Version,
+		&bid.Сoordination,
 		&bid.CreatedAt,
+		&bid.SealedPayload,
 	)
 	if err != nil {
 		return nil, err
@@ -46,7 +55,7 @@ func GetBidByID(bidID string) (*models.Bid, error) {
 		FROM feedback
 		WHERE bid_id = $1
 	`
-	rows, err := dbConn.Query(context.Background(), feedbackQuery, bidID)
+	rows, err := dbConn.Query(ctx, "GetBidByID", feedbackQuery, bidID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении отзывов: %v", err)
 	}
@@ -67,7 +76,7 @@ func GetBidByID(bidID string) (*models.Bid, error) {
 		FROM user_decisions
 		WHERE bid_id = $1
 	`
-	decisionRows, err := dbConn.Query(context.Background(), decisionQuery, bidID)
+	decisionRows, err := dbConn.Query(ctx, "GetBidByID", decisionQuery, bidID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении решений пользователей: %v", err)
 	}
@@ -87,16 +96,16 @@ func GetBidByID(bidID string) (*models.Bid, error) {
 	return &bid, nil
 }
 
-func GetBidsByUserID(authorID string, limit, offset int) ([]models.BidResponse, error) {
+func GetBidsByUserID(ctx context.Context, authorID string, limit, offset int) ([]models.BidResponse, error) {
 	bids := []models.BidResponse{}
 	query := `
-        SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at
+        SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at, sealed_payload IS NOT NULL
         FROM bids
         WHERE author_id = $1
         ORDER BY name
         LIMIT $2 OFFSET $3
     `
-	rows, err := dbConn.Query(context.Background(), query, authorID, limit, offset)
+	rows, err := dbConn.Query(ctx, "GetBidsByUserID", query, authorID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +114,7 @@ func GetBidsByUserID(authorID string, limit, offset int) ([]models.BidResponse,
 	for rows.Next() {
 		var bid models.BidResponse
 		var createdAt time.Time
-		if err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &createdAt); err != nil {
+		if err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &createdAt, &bid.Sealed); err != nil {
 			return nil, err
 		}
 		bid.CreatedAt = createdAt.Format(time.RFC3339)
@@ -118,18 +127,18 @@ func GetBidsByUserID(authorID string, limit, offset int) ([]models.BidResponse,
 	return bids, nil
 }
 
-func GetBidsByTenderID(tenderID string, limit, offset int) ([]models.BidResponse, error) {
+func GetBidsByTenderID(ctx context.Context, tenderID string, limit, offset int) ([]models.BidResponse, error) {
 	bids := []models.BidResponse{}
 
 	query := `
-        SELECT id, name, status, author_type, author_id, version, created_at
+        SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at, sealed_payload IS NOT NULL
         FROM bids
         WHERE tender_id = $1
         ORDER BY name
         LIMIT $2 OFFSET $3
     `
 
-	rows, err := dbConn.Query(context.Background(), query, tenderID, limit, offset)
+	rows, err := dbConn.Query(ctx, "GetBidsByTenderID", query, tenderID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +147,7 @@ func GetBidsByTenderID(tenderID string, limit, offset int) ([]models.BidResponse
 	for rows.Next() {
 		var bid models.BidResponse
 		var createdAt time.Time
-		if err := rows.Scan(&bid.ID, &bid.Name, &bid.Status, &bid.AuthorType, &bid.AuthorID, &bid.Version, &createdAt); err != nil {
+		if err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &createdAt, &bid.Sealed); err != nil {
 			return nil, err
 		}
 		bid.CreatedAt = createdAt.Format(time.RFC3339)
@@ -151,7 +160,7 @@ func GetBidsByTenderID(tenderID string, limit, offset int) ([]models.BidResponse
 }
 
 // версия 1
-func GetUserByUsername(username string) (*models.User, error) {
+func GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 
 	query := `
@@ -159,7 +168,7 @@ func GetUserByUsername(username string) (*models.User, error) {
 		FROM employee
 		WHERE username = $1
 	`
-	err := dbConn.QueryRow(context.Background(), query, username).Scan(
+	err := dbConn.QueryRow(ctx, "GetUserByUsername", query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.FirstName,
@@ -174,49 +183,175 @@ func GetUserByUsername(username string) (*models.User, error) {
 	return &user, nil
 }
 
-func CheckUserDecisionExists(userDecision *models.UserDecision) bool {
+// CheckUserDecisionExists проверяет, принимал ли пользователь решение по версии предложения
+// userDecision.BidVersion (и лоту, если он задан)
+func CheckUserDecisionExists(ctx context.Context, userDecision *models.UserDecision) bool {
 	var exists bool
 	query := `
 		SELECT EXISTS (
-			SELECT 1 FROM bid_decisions WHERE bid_id = $1 AND user_id = $2
+			SELECT 1 FROM bid_decisions
+			WHERE bid_id = $1 AND user_id = $2 AND lot_id IS NOT DISTINCT FROM NULLIF($3, '')::uuid
+			  AND bid_version = $4 AND invalidated_at IS NULL
 		)
 	`
-	dbConn.QueryRow(context.Background(), query, userDecision.BidID, userDecision.UserID).Scan(&exists)
+	dbConn.QueryRow(ctx, "CheckUserDecisionExists", query, userDecision.BidID, userDecision.UserID, userDecision.LotID, userDecision.BidVersion).Scan(&exists)
 	return exists
 }
 
-func SaveUserDecision(userDecision *models.UserDecision) error {
+// SaveUserDecision сохраняет решение по версии предложения userDecision.BidVersion.
+// Если предложение успели изменить и версия уже другая, возвращает ErrVersionConflict.
+func SaveUserDecision(ctx context.Context, userDecision *models.UserDecision) error {
 	query := `
-		INSERT INTO bid_decisions (id, bid_id, user_id, decision, created_at)
-		VALUES (uuid_generate_v4(), $1, $2, $3, CURRENT_TIMESTAMP)
+		INSERT INTO bid_decisions (id, bid_id, user_id, decision, lot_id, bid_version, comment, created_at)
+		SELECT uuid_generate_v4(), $1, $2, $3, NULLIF($4, '')::uuid, $5, $6, CURRENT_TIMESTAMP
+		WHERE EXISTS (SELECT 1 FROM bids WHERE id = $1 AND version = $5)
 		RETURNING id, created_at
 	`
-	err := dbConn.QueryRow(context.Background(), query, userDecision.BidID, userDecision.UserID, userDecision.Decision).
+	err := dbConn.QueryRow(ctx, "SaveUserDecision", query, userDecision.BidID, userDecision.UserID, userDecision.Decision, userDecision.LotID, userDecision.BidVersion, userDecision.Comment).
 		Scan(&userDecision.ID, &userDecision.Created_at)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrVersionConflict
+	}
 	return err
 }
 
-func SaveFeedback(feedback *models.Feedback) error {
+// HasBidDecisions сообщает, есть ли действующие решения по версии предложения version
+func HasBidDecisions(ctx context.Context, bidID string, version int) (bool, error) {
+	var exists bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM bid_decisions
+			WHERE bid_id = $1 AND bid_version = $2 AND invalidated_at IS NULL
+		)
+	`
+	err := dbConn.QueryRow(ctx, "HasBidDecisions", query, bidID, version).Scan(&exists)
+	return exists, err
+}
+
+// RevokeUserDecision отзывает действующее решение пользователя по версии предложения userDecision.BidVersion
+// (и лоту, если он задан). Отозвать решение можно, только пока предложение и лот ожидают решения;
+// если решения нет, возвращает pgx.ErrNoRows, а если предложение изменилось или решение по нему
+// уже принято — ErrVersionConflict.
+func RevokeUserDecision(ctx context.Context, userDecision *models.UserDecision) error {
+	return dbConn.BeginFunc(ctx, "RevokeUserDecision", func(tx querier) error {
+		var pending bool
+		query := `
+			SELECT b.version = $2 AND b.status = $4 AND b.coordination = $5
+			       AND NOT EXISTS (
+			           SELECT 1 FROM bid_lots AS l
+			           WHERE l.bid_id = b.id AND l.lot_id = NULLIF($3, '')::uuid AND l.coordination <> $5
+			       )
+			FROM bids AS b
+			WHERE b.id = $1
+			FOR UPDATE
+		`
+		err := tx.QueryRow(ctx, "RevokeUserDecision", query, userDecision.BidID, userDecision.BidVersion, userDecision.LotID, models.Published, models.Expectation).Scan(&pending)
+		if err != nil {
+			return err
+		}
+		if !pending {
+			return ErrVersionConflict
+		}
+
+		query = `
+			UPDATE bid_decisions SET revoked_at = CURRENT_TIMESTAMP, invalidated_at = CURRENT_TIMESTAMP
+			WHERE bid_id = $1 AND user_id = $2 AND lot_id IS NOT DISTINCT FROM NULLIF($3, '')::uuid
+			  AND bid_version = $4 AND invalidated_at IS NULL
+			RETURNING id, decision, comment, created_at, invalidated_at, revoked_at
+		`
+		return tx.QueryRow(ctx, "RevokeUserDecision", query, userDecision.BidID, userDecision.UserID, userDecision.LotID, userDecision.BidVersion).Scan(
+			&userDecision.ID,
+			&userDecision.Decision,
+			&userDecision.Comment,
+			&userDecision.Created_at,
+			&userDecision.InvalidatedAt,
+			&userDecision.RevokedAt,
+		)
+	})
+}
+
+// GetBidDecisions возвращает все решения по предложению, включая отозванные и аннулированные,
+// от последнего к первому; withComment оставляет только решения с комментарием
+func GetBidDecisions(ctx context.Context, bidID string, withComment bool, limit, offset int) ([]models.UserDecision, error) {
+	query := `
+		SELECT d.id, d.user_id, e.username, d.bid_id, COALESCE(d.lot_id::text, ''), COALESCE(d.bid_version, 0),
+		       d.decision, d.comment, d.created_at, d.invalidated_at, d.revoked_at
+		FROM bid_decisions AS d
+		JOIN employee AS e ON e.id = d.user_id
+		WHERE d.bid_id = $1 AND (NOT $2 OR d.comment <> '')
+		ORDER BY d.created_at DESC, d.id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := dbConn.Query(ctx, "GetBidDecisions", query, bidID, withComment, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decisions := []models.UserDecision{}
+	for rows.Next() {
+		var d models.UserDecision
+		err := rows.Scan(&d.ID, &d.UserID, &d.Username, &d.BidID, &d.LotID, &d.BidVersion,
+			&d.Decision, &d.Comment, &d.Created_at, &d.InvalidatedAt, &d.RevokedAt)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+// ErrFeedbackRated возвращается SaveFeedback, если пользователь уже оценил это предложение
+var ErrFeedbackRated = errors.New("предложение уже оценено")
+
+// SaveFeedback сохраняет отзыв. Оценить предложение пользователь может один раз,
+// текстовых отзывов без оценок может быть сколько угодно.
+func SaveFeedback(ctx context.Context, feedback *models.Feedback) error {
+	return saveFeedback(ctx, dbConn, feedback)
+}
+
+func saveFeedback(ctx context.Context, db querier, feedback *models.Feedback) error {
+	var quality, timeliness, communication *int
+	if r := feedback.Ratings; r != nil {
+		quality, timeliness, communication = &r.Quality, &r.Timeliness, &r.Communication
+	}
 	query := `
-		INSERT INTO feedback (id, user_id, bid_id, bid_feedback, created_at)
-		VALUES (uuid_generate_v4(), $1, $2, $3, CURRENT_TIMESTAMP)
+		INSERT INTO feedback (id, user_id, bid_id, bid_feedback, quality, timeliness, communication, created_at)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+		ON CONFLICT (bid_id, user_id) WHERE quality IS NOT NULL DO NOTHING
 		RETURNING id, created_at
 	`
-	err := dbConn.QueryRow(context.Background(), query, feedback.UserID, feedback.BidID, feedback.BidFeedback).
+	err := db.QueryRow(ctx, "saveFeedback", query, feedback.UserID, feedback.BidID, feedback.BidFeedback, quality, timeliness, communication).
 		Scan(&feedback.ID, &feedback.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrFeedbackRated
+	}
 	return err
 }
 
-func GetBidsByTenderIDWithExpectation(tenderID string) ([]models.Bid, error) {
+// feedbackRatings собирает оценки из столбцов отзыва; у текстового отзыва их нет
+func feedbackRatings(quality, timeliness, communication *int) *models.FeedbackRatings {
+	if quality == nil || timeliness == nil || communication == nil {
+		return nil
+	}
+	return &models.FeedbackRatings{Quality: *quality, Timeliness: *timeliness, Communication: *communication}
+}
+
+// GetBidsByTenderIDWithExpectation возвращает опубликованные предложения тендера, ожидающие решения.
+// Черновики и отозванные предложения в согласовании не участвуют и не возвращаются.
+func GetBidsByTenderIDWithExpectation(ctx context.Context, tenderID string) ([]models.Bid, error) {
 	var bids []models.Bid
 
 	query := `
-		SELECT id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at
+		SELECT id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at, sealed_payload
 		FROM bids
-		WHERE tender_id = $1 AND coordination = $2
+		WHERE tender_id = $1 AND coordination = $2 AND status = $3
 	`
 
-	rows, err := dbConn.Query(context.Background(), query, tenderID, models.Expectation)
+	rows, err := dbConn.Query(ctx, "GetBidsByTenderIDWithExpectation", query, tenderID, models.Expectation, models.Published)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении предложений: %v", err)
 	}
@@ -235,6 +370,7 @@ func GetBidsByTenderIDWithExpectation(tenderID string) ([]models.Bid, error) {
 			&bid.Version,
 			&bid.Сoordination,
 			&bid.CreatedAt,
+			&bid.SealedPayload,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании предложения: %v", err)
@@ -248,15 +384,18 @@ func GetBidsByTenderIDWithExpectation(tenderID string) ([]models.Bid, error) {
 	return bids, nil
 }
 
-func UpdateBid(bid *models.Bid) error {
+// UpdateBid сохраняет предложение, только если в базе всё ещё версия expectedVersion;
+// иначе возвращает ErrVersionConflict. Предложение, вскрытое после чтения, тоже даёт ErrVersionConflict:
+// иначе заглушки из прочитанной записи затёрли бы раскрытое содержимое.
+func UpdateBid(ctx context.Context, bid *models.Bid, expectedVersion int) error {
 	query := `
 		UPDATE bids 
 		SET name = $1, description = $2, status = $3, tender_id = $4, 
 		    author_type = $5, author_id = $6, 
-		    version = $7, coordination = $8
-		WHERE id = $9
+		    version = $7, coordination = $8, sealed_payload = $11
+		WHERE id = $9 AND version = $10 AND (sealed_payload IS NULL) = ($11::bytea IS NULL)
 	`
-	_, err := dbConn.Exec(context.Background(), query,
+	tag, err := dbConn.Exec(ctx, "UpdateBid", query,
 		bid.Name,
 		bid.Description,
 		bid.Status,
@@ -266,11 +405,19 @@ func UpdateBid(bid *models.Bid) error {
 		bid.Version,
 		bid.Сoordination,
 		bid.ID,
+		expectedVersion,
+		bid.SealedPayload,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrVersionConflict
+	}
+	return nil
 }
 
-func GetUserByID(userID string) (*models.User, error) {
+func GetUserByID(ctx context.Context, userID string) (*models.User, error) {
 	var user models.User
 
 	query := `
@@ -280,7 +427,7 @@ func GetUserByID(userID string) (*models.User, error) {
 	`
 
 	// Выполняем запрос к базе данных
-	err := dbConn.QueryRow(context.Background(), query, userID).Scan(
+	err := dbConn.QueryRow(ctx, "GetUserByID", query, userID).Scan(
 		&user.ID,
 		&user.Username,
 		&user.FirstName,
@@ -295,7 +442,7 @@ func GetUserByID(userID string) (*models.User, error) {
 	return &user, nil
 }
 
-func GetOrganizationByID(organizationID string) (*models.Organization, error) {
+func GetOrganizationByID(ctx context.Context, organizationID string) (*models.Organization, error) {
 	var organization models.Organization
 
 	query := `
@@ -303,7 +450,7 @@ func GetOrganizationByID(organizationID string) (*models.Organization, error) {
 		FROM organization
 		WHERE id = $1
 	`
-	err := dbConn.QueryRow(context.Background(), query, organizationID).Scan(
+	err := dbConn.QueryRow(ctx, "GetOrganizationByID", query, organizationID).Scan(
 		&organization.ID,
 		&organization.Name,
 		&organization.Type,
@@ -317,21 +464,22 @@ func GetOrganizationByID(organizationID string) (*models.Organization, error) {
 	return &organization, nil
 }
 
-func SaveBidHistory(bidHistory *models.BidHistory) error {
+func SaveBidHistory(ctx context.Context, bidHistory *models.BidHistory) error {
 	query := `
-		INSERT INTO bid_history (id, bid_id, name, description, version)
-		VALUES (uuid_generate_v4(), $1, $2, $3, $4)
+		INSERT INTO bid_history (id, bid_id, name, description, version, sealed_payload)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5)
 	`
-	_, err := dbConn.Exec(context.Background(), query,
+	_, err := dbConn.Exec(ctx, "SaveBidHistory", query,
 		bidHistory.BidID,
 		bidHistory.Name,
 		bidHistory.Description,
 		bidHistory.Version,
+		bidHistory.SealedPayload,
 	)
 	return err
 }
 
-func GetFeedbackByBidID(bidID string) ([]models.Feedback, error) {
+func GetFeedbackByBidID(ctx context.Context, bidID string) ([]models.Feedback, error) {
 	query := `
         SELECT id, user_id, bid_id, bid_feedback, created_at
         FROM feedback
@@ -339,7 +487,7 @@ func GetFeedbackByBidID(bidID string) ([]models.Feedback, error) {
         ORDER BY created_at ASC
     `
 
-	rows, err := dbConn.Query(context.Background(), query, bidID)
+	rows, err := dbConn.Query(ctx, "GetFeedbackByBidID", query, bidID)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +510,7 @@ func GetFeedbackByBidID(bidID string) ([]models.Feedback, error) {
 	return feedbacks, nil
 }
 
-func CheckBidExists(authorID, tenderID string) (bool, error) {
+func CheckBidExists(ctx context.Context, authorID, tenderID string) (bool, error) {
 	query := `
 		SELECT EXISTS (
 			SELECT 1 
@@ -371,21 +519,21 @@ func CheckBidExists(authorID, tenderID string) (bool, error) {
 		)
 	`
 	var exists bool
-	err := dbConn.QueryRow(context.Background(), query, authorID, tenderID).Scan(&exists)
+	err := dbConn.QueryRow(ctx, "CheckBidExists", query, authorID, tenderID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 	return exists, nil
 }
 
-func GetBidByTenderAndAuthorID(tenderID, authorID string) (*models.Bid, error) {
+func GetBidByTenderAndAuthorID(ctx context.Context, tenderID, authorID string) (*models.Bid, error) {
 	var bid models.Bid
 	query := `
 		SELECT id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at
 		FROM bids
 		WHERE tender_id = $1 AND author_id = $2
 	`
-	err := dbConn.QueryRow(context.Background(), query, tenderID, authorID).Scan(
+	err := dbConn.QueryRow(ctx, "GetBidByTenderAndAuthorID", query, tenderID, authorID).Scan(
 		&bid.ID,
 		&bid.Name,
 		&bid.Description,
@@ -404,15 +552,15 @@ func GetBidByTenderAndAuthorID(tenderID, authorID string) (*models.Bid, error) {
 	return &bid, nil
 }
 
-func GetBidReviews(bidID string, limit, offset int) ([]models.FeedbackResponse, error) {
+func GetBidReviews(ctx context.Context, bidID string, limit, offset int) ([]models.FeedbackResponse, error) {
 	query := `
-		SELECT id, bid_feedback, created_at
+		SELECT id, bid_feedback, quality, timeliness, communication, created_at
 		FROM feedback
-		WHERE bid_id = $1
+		WHERE bid_id = $1 AND hidden_at IS NULL
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
-	rows, err := dbConn.Query(context.Background(), query, bidID, limit, offset)
+	rows, err := dbConn.Query(ctx, "GetBidReviews", query, bidID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении отзывов: %v", err)
 	}
@@ -422,9 +570,11 @@ func GetBidReviews(bidID string, limit, offset int) ([]models.FeedbackResponse,
 	for rows.Next() {
 		var feedbackResponse models.FeedbackResponse
 		var createdAt time.Time
-		if err := rows.Scan(&feedbackResponse.ID, &feedbackResponse.Description, &createdAt); err != nil {
+		var quality, timeliness, communication *int
+		if err := rows.Scan(&feedbackResponse.ID, &feedbackResponse.Description, &quality, &timeliness, &communication, &createdAt); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании отзыва: %v", err)
 		}
+		feedbackResponse.Ratings = feedbackRatings(quality, timeliness, communication)
 		// Преобразуем время в формат RFC3339
 		feedbackResponse.CreatedAt = createdAt.Format(time.RFC3339)
 		feedbackResponses = append(feedbackResponses, feedbackResponse)
@@ -437,7 +587,7 @@ func GetBidReviews(bidID string, limit, offset int) ([]models.FeedbackResponse,
 	return feedbackResponses, nil
 }
 
-func CheckUserOrganization(userID string) bool {
+func CheckUserOrganization(ctx context.Context, userID string) bool {
 	query := `
 		SELECT EXISTS (
 			SELECT 1 
@@ -446,6 +596,6 @@ func CheckUserOrganization(userID string) bool {
 		)
 	`
 	var exists bool
-	dbConn.QueryRow(context.Background(), query, userID).Scan(&exists)
+	dbConn.QueryRow(ctx, "CheckUserOrganization", query, userID).Scan(&exists)
 	return exists
 }
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func SaveClarification(ctx context.Context, clarification *models.Clarification) error {
+	query := `
+		INSERT INTO tender_clarifications (id, tender_id, author_id, question, created_at)
+		VALUES (uuid_generate_v4(), $1, $2, $3, CURRENT_TIMESTAMP)
+		RETURNING id, created_at
+	`
+	return dbConn.QueryRow(ctx, "SaveClarification", query, clarification.TenderID, clarification.AuthorID, clarification.Question).
+		Scan(&clarification.ID, &clarification.CreatedAt)
+}
+
+const clarificationColumns = `
+	id, tender_id, author_id, question, COALESCE(answer, ''), COALESCE(answered_by::text, ''),
+	created_at, answered_at
+`
+
+func scanClarification(row pgx.Row, clarification *models.Clarification) error {
+	return row.Scan(
+		&clarification.ID,
+		&clarification.TenderID,
+		&clarification.AuthorID,
+		&clarification.Question,
+		&clarification.Answer,
+		&clarification.AnsweredBy,
+		&clarification.CreatedAt,
+		&clarification.AnsweredAt,
+	)
+}
+
+// GetClarifications возвращает вопросы по тендеру в порядке поступления. Если all не задан,
+// возвращаются только вопросы с ответом и вопросы пользователя authorID (он может быть пуст).
+func GetClarifications(ctx context.Context, tenderID string, all bool, authorID string, limit, offset int) ([]models.Clarification, error) {
+	query := `SELECT ` + clarificationColumns + `
+		FROM tender_clarifications
+		WHERE tender_id = $1
+		  AND ($2 OR answer IS NOT NULL OR author_id::text = $3)
+		ORDER BY created_at, id
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := dbConn.Query(ctx, "GetClarifications", query, tenderID, all, authorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clarifications := []models.Clarification{}
+	for rows.Next() {
+		var clarification models.Clarification
+		if err := scanClarification(rows, &clarification); err != nil {
+			return nil, err
+		}
+		clarifications = append(clarifications, clarification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return clarifications, nil
+}
+
+func GetClarificationByID(ctx context.Context, clarificationID string) (*models.Clarification, error) {
+	var clarification models.Clarification
+	query := `SELECT ` + clarificationColumns + ` FROM tender_clarifications WHERE id = $1`
+	if err := scanClarification(dbConn.QueryRow(ctx, "GetClarificationByID", query, clarificationID), &clarification); err != nil {
+		return nil, err
+	}
+	return &clarification, nil
+}
+
+// AnswerClarification сохраняет ответ; повторный ответ заменяет предыдущий
+func AnswerClarification(ctx context.Context, clarification *models.Clarification) error {
+	query := `
+		UPDATE tender_clarifications
+		SET answer = $1, answered_by = $2, answered_at = CURRENT_TIMESTAMP
+		WHERE id = $3
+		RETURNING answered_at
+	`
+	return dbConn.QueryRow(ctx, "AnswerClarification", query, clarification.Answer, clarification.AnsweredBy, clarification.ID).
+		Scan(&clarification.AnsweredAt)
+}
@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ReplaceCriteria заменяет критерии оценки тендера одним запросом и заполняет ID новых критериев.
+// Оценки по прежним критериям удаляются каскадно.
+func ReplaceCriteria(ctx context.Context, tenderID string, criteria []models.Criterion) error {
+	names := make([]string, len(criteria))
+	descriptions := make([]string, len(criteria))
+	weights := make([]float64, len(criteria))
+	for i, criterion := range criteria {
+		names[i] = criterion.Name
+		descriptions[i] = criterion.Description
+		weights[i] = criterion.Weight
+	}
+	query := `
+		WITH deleted AS (
+			DELETE FROM tender_criteria WHERE tender_id = $1
+		)
+		INSERT INTO tender_criteria (id, tender_id, position, name, description, weight, created_at)
+		SELECT uuid_generate_v4(), $1, c.position, c.name, c.description, c.weight, CURRENT_TIMESTAMP
+		FROM unnest($2::text[], $3::text[], $4::float8[]) WITH ORDINALITY AS c(name, description, weight, position)
+		RETURNING id, position, created_at
+	`
+	rows, err := dbConn.Query(ctx, "ReplaceCriteria", query, tenderID, pq.Array(names), pq.Array(descriptions), pq.Array(weights))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var position int
+		var createdAt time.Time
+		if err := rows.Scan(&id, &position, &createdAt); err != nil {
+			return err
+		}
+		criterion := &criteria[position-1]
+		criterion.ID = id
+		criterion.TenderID = tenderID
+		criterion.Position = position
+		criterion.CreatedAt = createdAt
+	}
+	return rows.Err()
+}
+
+// GetCriteria возвращает критерии оценки тендера в порядке их номеров
+func GetCriteria(ctx context.Context, tenderID string) ([]models.Criterion, error) {
+	query := `
+		SELECT id, tender_id, position, name, description, weight::float8, created_at
+		FROM tender_criteria
+		WHERE tender_id = $1
+		ORDER BY position
+	`
+	rows, err := dbConn.Query(ctx, "GetCriteria", query, tenderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	criteria := []models.Criterion{}
+	for rows.Next() {
+		var criterion models.Criterion
+		if err := rows.Scan(&criterion.ID, &criterion.TenderID, &criterion.Position, &criterion.Name, &criterion.Description, &criterion.Weight, &criterion.CreatedAt); err != nil {
+			return nil, err
+		}
+		criteria = append(criteria, criterion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return criteria, nil
+}
+
+// HasBidScores сообщает, оценено ли хотя бы одно предложение по тендеру
+func HasBidScores(ctx context.Context, tenderID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM bid_scores AS s
+			JOIN tender_criteria AS c ON c.id = s.criterion_id
+			WHERE c.tender_id = $1
+		)
+	`
+	var exists bool
+	err := dbConn.QueryRow(ctx, "HasBidScores", query, tenderID).Scan(&exists)
+	return exists, err
+}
+
+// SaveBidScores сохраняет оценки одного ответственного; повторная оценка по критерию заменяет прежнюю
+func SaveBidScores(ctx context.Context, scores []models.BidScore) error {
+	query := `
+		INSERT INTO bid_scores (bid_id, criterion_id, evaluator_id, score, updated_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+		ON CONFLICT (bid_id, criterion_id, evaluator_id)
+		DO UPDATE SET score = EXCLUDED.score, updated_at = EXCLUDED.updated_at
+	`
+	for _, score := range scores {
+		if _, err := dbConn.Exec(ctx, "SaveBidScores", query, score.BidID, score.CriterionID, score.EvaluatorID, score.Score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBidScoresByTenderID возвращает все оценки предложений по тендеру
+func GetBidScoresByTenderID(ctx context.Context, tenderID string) ([]models.BidScore, error) {
+	query := `
+		SELECT s.bid_id, s.criterion_id, s.evaluator_id, s.score, s.updated_at
+		FROM bid_scores AS s
+		JOIN tender_criteria AS c ON c.id = s.criterion_id
+		WHERE c.tender_id = $1
+	`
+	rows, err := dbConn.Query(ctx, "GetBidScoresByTenderID", query, tenderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := []models.BidScore{}
+	for rows.Next() {
+		var score models.BidScore
+		if err := rows.Scan(&score.BidID, &score.CriterionID, &score.EvaluatorID, &score.Score, &score.UpdatedAt); err != nil {
+			return nil, err
+		}
+		scores = append(scores, score)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// GetRankedBids возвращает опубликованные и закрытые предложения по тендеру: черновики и отменённые
+// предложения в рейтинг не попадают
+func GetRankedBids(ctx context.Context, tenderID string) ([]models.Bid, error) {
+	query := `
+		SELECT id, name, description, status, tender_id, author_type, author_id, version, coordination, created_at
+		FROM bids
+		WHERE tender_id = $1 AND status = ANY($2)
+		ORDER BY created_at, id
+	`
+	statuses := []string{string(models.Published), string(models.Closed)}
+	rows, err := dbConn.Query(ctx, "GetRankedBids", query, tenderID, pq.Array(statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bids := []models.Bid{}
+	for rows.Next() {
+		var bid models.Bid
+		err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.Сoordination, &bid.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		bids = append(bids, bid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bids, nil
+}
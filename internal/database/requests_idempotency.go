@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// ReserveIdempotencyKey занимает ключ под выполняющийся запрос. Ключ старше ttl считается свободным
+// и перезаписывается, как и резерв без сохранённого ответа старше lease: запрос, занявший ключ,
+// прервался вместе с процессом. Возвращает false, если ключ уже занят.
+func ReserveIdempotencyKey(ctx context.Context, record *models.IdempotencyKey, ttl, lease time.Duration) (bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_key, idempotency_key, request_hash, status_code, content_type, response_body, created_at)
+		VALUES ($1, $2, $3, 0, '', NULL, CURRENT_TIMESTAMP)
+		ON CONFLICT (user_key, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = 0,
+			content_type = '',
+			response_body = NULL,
+			created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.created_at < CURRENT_TIMESTAMP - make_interval(secs => $4)
+		   OR idempotency_keys.status_code = 0 AND idempotency_keys.created_at < CURRENT_TIMESTAMP - make_interval(secs => $5)
+		RETURNING created_at
+	`
+	err := dbConn.QueryRow(ctx, "ReserveIdempotencyKey", query, record.UserKey, record.Key, record.RequestHash, ttl.Seconds(), lease.Seconds()).Scan(&record.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func GetIdempotencyKey(ctx context.Context, userKey, key string) (*models.IdempotencyKey, error) {
+	record := models.IdempotencyKey{UserKey: userKey, Key: key}
+	query := `
+		SELECT request_hash, status_code, content_type, response_body, created_at
+		FROM idempotency_keys
+		WHERE user_key = $1 AND idempotency_key = $2
+	`
+	err := dbConn.QueryRow(ctx, "GetIdempotencyKey", query, userKey, key).Scan(
+		&record.RequestHash,
+		&record.StatusCode,
+		&record.ContentType,
+		&record.ResponseBody,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// CompleteIdempotencyKey сохраняет ответ, если ключ всё ещё занят этим запросом,
+// а не перехвачен повтором после истечения резерва
+func CompleteIdempotencyKey(ctx context.Context, record *models.IdempotencyKey) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $4, content_type = $5, response_body = $6
+		WHERE user_key = $1 AND idempotency_key = $2 AND created_at = $3
+	`
+	_, err := dbConn.Exec(ctx, "CompleteIdempotencyKey", query, record.UserKey, record.Key, record.CreatedAt, record.StatusCode, record.ContentType, record.ResponseBody)
+	return err
+}
+
+// DeleteIdempotencyKey освобождает ключ, занятый этим запросом
+func DeleteIdempotencyKey(ctx context.Context, record *models.IdempotencyKey) error {
+	query := `DELETE FROM idempotency_keys WHERE user_key = $1 AND idempotency_key = $2 AND created_at = $3`
+	_, err := dbConn.Exec(ctx, "DeleteIdempotencyKey", query, record.UserKey, record.Key, record.CreatedAt)
+	return err
+}
+
+// DeleteExpiredIdempotencyKeys удаляет ключи старше ttl и возвращает их число
+func DeleteExpiredIdempotencyKeys(ctx context.Context, ttl time.Duration) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE created_at < CURRENT_TIMESTAMP - make_interval(secs => $1)`
+	tag, err := dbConn.Exec(ctx, "DeleteExpiredIdempotencyKeys", query, ttl.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrAlreadyInvited возвращается, если адресат уже приглашён к тендеру
+	ErrAlreadyInvited = errors.New("адресат уже приглашён")
+	// ErrInvitationState возвращается, если на приглашение уже ответили
+	ErrInvitationState = errors.New("на приглашение уже ответили")
+)
+
+const invitationColumns = `
+	i.id, i.tender_id, t.name, COALESCE(i.user_id::text, ''), COALESCE(e.username, ''), COALESCE(i.organization_id::text, ''),
+	i.status, i.invited_by, COALESCE(i.responded_by::text, ''), i.created_at, i.responded_at
+`
+
+const invitationTables = `
+	tender_invitations AS i
+	JOIN tenders AS t ON t.id = i.tender_id
+	LEFT JOIN employee AS e ON e.id = i.user_id
+`
+
+func scanInvitation(row pgx.Row, invitation *models.TenderInvitation) error {
+	return row.Scan(
+		&invitation.ID,
+		&invitation.TenderID,
+		&invitation.TenderName,
+		&invitation.UserID,
+		&invitation.Username,
+		&invitation.OrganizationID,
+		&invitation.Status,
+		&invitation.InvitedBy,
+		&invitation.RespondedBy,
+		&invitation.CreatedAt,
+		&invitation.RespondedAt,
+	)
+}
+
+// SaveTenderInvitation сохраняет приглашение; повторное приглашение того же адресата даёт ErrAlreadyInvited
+func SaveTenderInvitation(ctx context.Context, invitation *models.TenderInvitation) error {
+	query := `
+		INSERT INTO tender_invitations (tender_id, user_id, organization_id, status, invited_by)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5)
+		ON CONFLICT (tender_id, COALESCE(user_id, organization_id)) DO NOTHING
+		RETURNING id, status, created_at
+	`
+	err := dbConn.QueryRow(ctx, "SaveTenderInvitation", query, invitation.TenderID, invitation.UserID, invitation.OrganizationID, models.InvitationPending, invitation.InvitedBy).
+		Scan(&invitation.ID, &invitation.Status, &invitation.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAlreadyInvited
+	}
+	return err
+}
+
+func GetTenderInvitationByID(ctx context.Context, invitationID string) (*models.TenderInvitation, error) {
+	var invitation models.TenderInvitation
+	query := `SELECT ` + invitationColumns + ` FROM ` + invitationTables + ` WHERE i.id = $1`
+	if err := scanInvitation(dbConn.QueryRow(ctx, "GetTenderInvitationByID", query, invitationID), &invitation); err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// GetTenderInvitations возвращает приглашения к тендеру в порядке отправки
+func GetTenderInvitations(ctx context.Context, tenderID string, limit, offset int) ([]models.TenderInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM ` + invitationTables + `
+		WHERE i.tender_id = $1
+		ORDER BY i.created_at, i.id
+		LIMIT $2 OFFSET $3
+	`
+	return queryInvitations(ctx, query, tenderID, limit, offset)
+}
+
+// GetUserInvitations возвращает приглашения пользователю и организациям, за которые он отвечает,
+// начиная с последних
+func GetUserInvitations(ctx context.Context, userID string, limit, offset int) ([]models.TenderInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM ` + invitationTables + `
+		WHERE i.user_id = $1
+		   OR i.organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = $1)
+		ORDER BY i.created_at DESC, i.id
+		LIMIT $2 OFFSET $3
+	`
+	return queryInvitations(ctx, query, userID, limit, offset)
+}
+
+func queryInvitations(ctx context.Context, query string, args ...interface{}) ([]models.TenderInvitation, error) {
+	rows, err := dbConn.Query(ctx, "queryInvitations", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []models.TenderInvitation{}
+	for rows.Next() {
+		var invitation models.TenderInvitation
+		if err := scanInvitation(rows, &invitation); err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// RespondTenderInvitation принимает или отклоняет приглашение, если на него ещё не ответили;
+// иначе возвращает ErrInvitationState
+func RespondTenderInvitation(ctx context.Context, invitation *models.TenderInvitation) error {
+	query := `
+		UPDATE tender_invitations SET status = $2, responded_by = $3, responded_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = $4
+		RETURNING responded_at
+	`
+	err := dbConn.QueryRow(ctx, "RespondTenderInvitation", query, invitation.ID, invitation.Status, invitation.RespondedBy, models.InvitationPending).
+		Scan(&invitation.RespondedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvitationState
+	}
+	return err
+}
+
+func DeleteTenderInvitation(ctx context.Context, tenderID, invitationID string) error {
+	query := `DELETE FROM tender_invitations WHERE id = $1 AND tender_id = $2`
+	tag, err := dbConn.Exec(ctx, "DeleteTenderInvitation", query, invitationID, tenderID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// GetInvitationStatus возвращает лучший статус приглашений пользователя к тендеру — лично ему
+// или организациям, за которые он отвечает: Accepted, если хотя бы одно принято, иначе Pending
+// или Declined. Пустая строка — приглашений нет.
+func GetInvitationStatus(ctx context.Context, tenderID, userID string) (models.InvitationStatus, error) {
+	query := `
+		SELECT status
+		FROM tender_invitations
+		WHERE tender_id = $1
+		  AND (user_id = $2 OR organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = $2))
+		ORDER BY CASE status WHEN $3 THEN 0 WHEN $4 THEN 1 ELSE 2 END
+		LIMIT 1
+	`
+	var status models.InvitationStatus
+	err := dbConn.QueryRow(ctx, "GetInvitationStatus", query, tenderID, userID, models.InvitationAccepted, models.InvitationPending).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return status, err
+}
+
+// GetInvitationStatusesByTenderIDs возвращает для каждого тендера из tenderIDs, куда пользователь приглашён,
+// лучший статус приглашений, как GetInvitationStatus; заполнены только TenderID и Status
+func GetInvitationStatusesByTenderIDs(ctx context.Context, userID string, tenderIDs []string) ([]models.TenderInvitation, error) {
+	query := `
+		SELECT DISTINCT ON (tender_id) tender_id, status
+		FROM tender_invitations
+		WHERE tender_id = ANY($2)
+		  AND (user_id = $1 OR organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = $1))
+		ORDER BY tender_id, CASE status WHEN $3 THEN 0 WHEN $4 THEN 1 ELSE 2 END
+	`
+	rows, err := dbConn.Query(ctx, "GetInvitationStatusesByTenderIDs", query, userID, pq.Array(tenderIDs), models.InvitationAccepted, models.InvitationPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []models.TenderInvitation{}
+	for rows.Next() {
+		var invitation models.TenderInvitation
+		if err := rows.Scan(&invitation.TenderID, &invitation.Status); err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// ErrLotAwarded возвращается AwardLot, если лот уже присуждён другому предложению
+var ErrLotAwarded = errors.New("лот уже присуждён")
+
+// SaveLots сохраняет лоты нового тендера в порядке передачи и заполняет их ID
+func SaveLots(ctx context.Context, tenderID string, lots []models.Lot) error {
+	query := `
+		INSERT INTO tender_lots (id, tender_id, position, name, description, quantity, budget, created_at)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+		RETURNING id, created_at
+	`
+	for i := range lots {
+		lot := &lots[i]
+		lot.TenderID = tenderID
+		lot.Position = i + 1
+		err := dbConn.QueryRow(ctx, "SaveLots", query, tenderID, lot.Position, lot.Name, lot.Description, lot.Quantity, lot.Budget).
+			Scan(&lot.ID, &lot.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLotsByTenderIDs возвращает лоты тендеров, упорядоченные по тендеру и номеру лота
+func GetLotsByTenderIDs(ctx context.Context, tenderIDs []string) ([]models.Lot, error) {
+	query := `
+		SELECT id, tender_id, position, name, description, quantity, budget::float8,
+		       COALESCE(awarded_bid_id::text, ''), created_at
+		FROM tender_lots
+		WHERE tender_id = ANY($1)
+		ORDER BY tender_id, position
+	`
+	rows, err := dbConn.Query(ctx, "GetLotsByTenderIDs", query, pq.Array(tenderIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lots := []models.Lot{}
+	for rows.Next() {
+		var lot models.Lot
+		if err := rows.Scan(&lot.ID, &lot.TenderID, &lot.Position, &lot.Name, &lot.Description, &lot.Quantity, &lot.Budget, &lot.AwardedBidID, &lot.CreatedAt); err != nil {
+			return nil, err
+		}
+		lots = append(lots, lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func SaveBidLots(ctx context.Context, bidLots []models.BidLot) error {
+	return saveBidLots(ctx, dbConn, bidLots)
+}
+
+func saveBidLots(ctx context.Context, db querier, bidLots []models.BidLot) error {
+	query := `
+		INSERT INTO bid_lots (bid_id, lot_id, price, coordination)
+		VALUES ($1, $2, $3, $4)
+	`
+	for _, bidLot := range bidLots {
+		if _, err := db.Exec(ctx, "saveBidLots", query, bidLot.BidID, bidLot.LotID, bidLot.Price, bidLot.Сoordination); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBidLotsByBidIDs возвращает участие предложений в лотах в порядке номеров лотов
+func GetBidLotsByBidIDs(ctx context.Context, bidIDs []string) ([]models.BidLot, error) {
+	query := `
+		SELECT bl.bid_id, bl.lot_id, bl.price::float8, bl.coordination
+		FROM bid_lots AS bl
+		JOIN tender_lots AS l ON l.id = bl.lot_id
+		WHERE bl.bid_id = ANY($1)
+		ORDER BY bl.bid_id, l.position
+	`
+	rows, err := dbConn.Query(ctx, "GetBidLotsByBidIDs", query, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bidLots := []models.BidLot{}
+	for rows.Next() {
+		var bidLot models.BidLot
+		if err := rows.Scan(&bidLot.BidID, &bidLot.LotID, &bidLot.Price, &bidLot.Сoordination); err != nil {
+			return nil, err
+		}
+		bidLots = append(bidLots, bidLot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bidLots, nil
+}
+
+func UpdateBidLotCoordination(ctx context.Context, bidID, lotID string, coordination models.Сoordination) error {
+	query := `
+		UPDATE bid_lots SET coordination = $3
+		WHERE bid_id = $1 AND lot_id = $2
+	`
+	_, err := dbConn.Exec(ctx, "UpdateBidLotCoordination", query, bidID, lotID, coordination)
+	return err
+}
+
+// AwardLot присуждает лот предложению. Лот присуждается один раз: если его уже получило
+// другое предложение, возвращается ErrLotAwarded.
+func AwardLot(ctx context.Context, lotID, bidID string) error {
+	query := `
+		UPDATE tender_lots SET awarded_bid_id = $2
+		WHERE id = $1 AND awarded_bid_id IS NULL
+	`
+	tag, err := dbConn.Exec(ctx, "AwardLot", query, lotID, bidID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrLotAwarded
+	}
+	return nil
+}
+
+// RejectLotCompetitors отклоняет участие в лоте остальных ожидающих решения предложений
+// и возвращает их ID
+func RejectLotCompetitors(ctx context.Context, lotID, winnerBidID string) ([]string, error) {
+	query := `
+		UPDATE bid_lots SET coordination = $3
+		WHERE lot_id = $1 AND bid_id <> $2 AND coordination = $4
+		RETURNING bid_id
+	`
+	rows, err := dbConn.Query(ctx, "RejectLotCompetitors", query, lotID, winnerBidID, models.RejectedByConflict, models.Expectation)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bidIDs := []string{}
+	for rows.Next() {
+		var bidID string
+		if err := rows.Scan(&bidID); err != nil {
+			return nil, err
+		}
+		bidIDs = append(bidIDs, bidID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bidIDs, nil
+}
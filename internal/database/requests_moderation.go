@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrAlreadyReported возвращается, если у пользователя уже есть открытая жалоба на это содержимое
+	ErrAlreadyReported = errors.New("жалоба уже подана")
+	// ErrModerationState возвращается, если содержимое уже скрыто (или уже не скрыто)
+	ErrModerationState = errors.New("содержимое уже в этом состоянии")
+)
+
+// GetFeedbackByID возвращает отзыв, в том числе скрытый модератором
+func GetFeedbackByID(ctx context.Context, feedbackID string) (*models.Feedback, error) {
+	var feedback models.Feedback
+	var quality, timeliness, communication *int
+	query := `
+		SELECT id, user_id, bid_id, bid_feedback, quality, timeliness, communication, created_at, hidden_at
+		FROM feedback
+		WHERE id = $1
+	`
+	err := dbConn.QueryRow(ctx, "GetFeedbackByID", query, feedbackID).Scan(
+		&feedback.ID,
+		&feedback.UserID,
+		&feedback.BidID,
+		&feedback.BidFeedback,
+		&quality,
+		&timeliness,
+		&communication,
+		&feedback.CreatedAt,
+		&feedback.HiddenAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	feedback.Ratings = feedbackRatings(quality, timeliness, communication)
+	return &feedback, nil
+}
+
+// GetFeedbackReply возвращает ответ на отзыв feedbackID, в том числе скрытый модератором
+func GetFeedbackReply(ctx context.Context, feedbackID, replyID string) (*models.FeedbackReply, error) {
+	var reply models.FeedbackReply
+	query := `
+		SELECT r.id, r.feedback_id, r.author_id, e.username, r.text, r.created_at, r.hidden_at
+		FROM feedback_replies AS r
+		JOIN employee AS e ON e.id = r.author_id
+		WHERE r.id = $1 AND r.feedback_id = $2
+	`
+	err := dbConn.QueryRow(ctx, "GetFeedbackReply", query, replyID, feedbackID).Scan(
+		&reply.ID,
+		&reply.FeedbackID,
+		&reply.AuthorID,
+		&reply.Username,
+		&reply.Text,
+		&reply.CreatedAt,
+		&reply.HiddenAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+func SaveFeedbackReply(ctx context.Context, reply *models.FeedbackReply) error {
+	query := `
+		INSERT INTO feedback_replies (feedback_id, author_id, text)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+	return dbConn.QueryRow(ctx, "SaveFeedbackReply", query, reply.FeedbackID, reply.AuthorID, reply.Text).Scan(&reply.ID, &reply.CreatedAt)
+}
+
+// GetFeedbackReplies возвращает не скрытые ответы на отзывы feedbackIDs в хронологическом порядке
+func GetFeedbackReplies(ctx context.Context, feedbackIDs []string) ([]models.FeedbackReply, error) {
+	query := `
+		SELECT r.id, r.feedback_id, r.author_id, e.username, r.text, r.created_at
+		FROM feedback_replies AS r
+		JOIN employee AS e ON e.id = r.author_id
+		WHERE r.feedback_id = ANY($1) AND r.hidden_at IS NULL
+		ORDER BY r.created_at, r.id
+	`
+	rows, err := dbConn.Query(ctx, "GetFeedbackReplies", query, pq.Array(feedbackIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	replies := []models.FeedbackReply{}
+	for rows.Next() {
+		var reply models.FeedbackReply
+		if err := rows.Scan(&reply.ID, &reply.FeedbackID, &reply.AuthorID, &reply.Username, &reply.Text, &reply.CreatedAt); err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
+
+// SaveFeedbackReport сохраняет жалобу; повторная жалоба пользователя, пока первая открыта, даёт ErrAlreadyReported
+func SaveFeedbackReport(ctx context.Context, report *models.FeedbackReport) error {
+	query := `
+		INSERT INTO feedback_reports (feedback_id, reply_id, reporter_id, reason)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4)
+		ON CONFLICT (feedback_id, COALESCE(reply_id, feedback_id), reporter_id) WHERE resolved_at IS NULL DO NOTHING
+		RETURNING id, created_at
+	`
+	err := dbConn.QueryRow(ctx, "SaveFeedbackReport", query, report.FeedbackID, report.ReplyID, report.ReporterID, report.Reason).
+		Scan(&report.ID, &report.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAlreadyReported
+	}
+	return err
+}
+
+// GetModerationQueue возвращает отзывы и ответы с открытыми жалобами, начиная с самых давних.
+// Если all не задан, только по тендерам организаций, за которые отвечает moderatorID.
+func GetModerationQueue(ctx context.Context, all bool, moderatorID string, limit, offset int) ([]models.ModerationItem, error) {
+	query := `
+		SELECT f.id, COALESCE(r.id::text, ''), b.id, b.tender_id, COALESCE(r.text, f.bid_feedback),
+		       COUNT(*), array_agg(p.reason ORDER BY p.created_at), MIN(p.created_at),
+		       CASE WHEN r.id IS NULL THEN f.hidden_at ELSE r.hidden_at END
+		FROM feedback_reports AS p
+		JOIN feedback AS f ON f.id = p.feedback_id
+		LEFT JOIN feedback_replies AS r ON r.id = p.reply_id
+		JOIN bids AS b ON b.id = f.bid_id
+		JOIN tenders AS t ON t.id = b.tender_id
+		WHERE p.resolved_at IS NULL
+		  AND ($1 OR t.organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = $2))
+		GROUP BY f.id, r.id, b.id
+		ORDER BY MIN(p.created_at), f.id, r.id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := dbConn.Query(ctx, "GetModerationQueue", query, all, moderatorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []models.ModerationItem{}
+	for rows.Next() {
+		var item models.ModerationItem
+		err := rows.Scan(&item.FeedbackID, &item.ReplyID, &item.BidID, &item.TenderID, &item.Text,
+			&item.Reports, &item.Reasons, &item.FirstReportedAt, &item.HiddenAt)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ModerateFeedback скрывает (hide) или восстанавливает отзыв либо ответ на него, закрывает открытые жалобы
+// на это содержимое и пишет событие в журнал тендера одной транзакцией
+func ModerateFeedback(ctx context.Context, feedbackID, replyID string, hide bool, event *models.AuditEvent) error {
+	return dbConn.BeginFunc(ctx, "ModerateFeedback", func(tx querier) error {
+		query := `
+			UPDATE feedback SET hidden_at = CASE WHEN $2 THEN CURRENT_TIMESTAMP END
+			WHERE id = $1 AND (hidden_at IS NULL) = $2
+		`
+		args := []interface{}{feedbackID, hide}
+		if replyID != "" {
+			query = `
+				UPDATE feedback_replies SET hidden_at = CASE WHEN $2 THEN CURRENT_TIMESTAMP END
+				WHERE id = $3 AND feedback_id = $1 AND (hidden_at IS NULL) = $2
+			`
+			args = append(args, replyID)
+		}
+		tag, err := tx.Exec(ctx, "ModerateFeedback", query, args...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrModerationState
+		}
+
+		query = `
+			UPDATE feedback_reports SET resolved_at = CURRENT_TIMESTAMP
+			WHERE feedback_id = $1 AND reply_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid AND resolved_at IS NULL
+		`
+		if _, err := tx.Exec(ctx, "ModerateFeedback", query, feedbackID, replyID); err != nil {
+			return err
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"tender-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// GetReputations считает репутацию по отзывам с оценками: пользователей userIDs — по их предложениям
+// от своего имени, организаций organizationIDs — по предложениям от имени организации, поданным
+// её ответственными. Скрытые модератором отзывы не учитываются.
+// Авторы без таких отзывов в результат не попадают.
+func GetReputations(ctx context.Context, userIDs, organizationIDs []string) ([]models.Reputation, error) {
+	query := `
+		SELECT $3::varchar, b.author_id, COUNT(*), COUNT(DISTINCT b.tender_id),
+		       AVG(f.quality)::float8, AVG(f.timeliness)::float8, AVG(f.communication)::float8
+		FROM feedback AS f
+		JOIN bids AS b ON b.id = f.bid_id
+		WHERE b.author_type = $3 AND b.author_id = ANY($1) AND f.quality IS NOT NULL AND f.hidden_at IS NULL
+		GROUP BY b.author_id
+		UNION ALL
+		SELECT $4::varchar, rated.organization_id, COUNT(*), COUNT(DISTINCT rated.tender_id),
+		       AVG(rated.quality)::float8, AVG(rated.timeliness)::float8, AVG(rated.communication)::float8
+		FROM (
+			SELECT DISTINCT r.organization_id, f.id, b.tender_id, f.quality, f.timeliness, f.communication
+			FROM feedback AS f
+			JOIN bids AS b ON b.id = f.bid_id
+			JOIN organization_responsible AS r ON r.user_id = b.author_id
+			WHERE b.author_type = $4 AND r.organization_id = ANY($2) AND f.quality IS NOT NULL AND f.hidden_at IS NULL
+		) AS rated
+		GROUP BY rated.organization_id
+	`
+	rows, err := dbConn.Query(ctx, "GetReputations", query, pq.Array(userIDs), pq.Array(organizationIDs), models.AuthorTypeUser, models.AuthorTypeOrganization)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reputations := []models.Reputation{}
+	for rows.Next() {
+		var r models.Reputation
+		if err := rows.Scan(&r.AuthorType, &r.AuthorID, &r.Reviews, &r.Tenders, &r.Quality, &r.Timeliness, &r.Communication); err != nil {
+			return nil, err
+		}
+		reputations = append(reputations, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reputations, nil
+}
+
+// GetAuthorOrganizations возвращает организации, за которые отвечают пользователи userIDs:
+// от их имени подаются предложения с типом автора Organization
+func GetAuthorOrganizations(ctx context.Context, userIDs []string) ([]models.OrganizationResponsible, error) {
+	query := `
+		SELECT DISTINCT user_id, organization_id
+		FROM organization_responsible
+		WHERE user_id = ANY($1)
+		ORDER BY user_id, organization_id
+	`
+	rows, err := dbConn.Query(ctx, "GetAuthorOrganizations", query, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	responsibles := []models.OrganizationResponsible{}
+	for rows.Next() {
+		var responsible models.OrganizationResponsible
+		if err := rows.Scan(&responsible.UserID, &responsible.OrganizationID); err != nil {
+			return nil, err
+		}
+		responsibles = append(responsibles, responsible)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return responsibles, nil
+}
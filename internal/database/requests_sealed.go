@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// ErrTenderOpened возвращается SaveSealedBid, если тендер вскрыт после проверки срока подачи
+var ErrTenderOpened = errors.New("тендер уже вскрыт")
+
+// SaveSealedBid сохраняет запечатанное предложение вместе с лотами, если тендер ещё не вскрыт.
+// Строка тендера блокируется до конца транзакции, так что вскрытие не пройдёт между проверкой и вставкой
+// и не оставит предложение зашифрованным навсегда.
+func SaveSealedBid(ctx context.Context, bid *models.Bid, bidLots []models.BidLot) error {
+	return dbConn.BeginFunc(ctx, "SaveSealedBid", func(tx querier) error {
+		var opened bool
+		query := `SELECT opened_at IS NOT NULL FROM tenders WHERE id = $1 FOR SHARE`
+		if err := tx.QueryRow(ctx, "SaveSealedBid", query, bid.TenderID).Scan(&opened); err != nil {
+			return err
+		}
+		if opened {
+			return ErrTenderOpened
+		}
+		if err := saveBid(ctx, tx, bid); err != nil {
+			return err
+		}
+		for i := range bidLots {
+			bidLots[i].BidID = bid.ID
+		}
+		return saveBidLots(ctx, tx, bidLots)
+	})
+}
+
+// GetSealedPayloads возвращает зашифрованное содержимое тех из предложений bidIDs, что ещё не вскрыты
+func GetSealedPayloads(ctx context.Context, bidIDs []string) ([]models.SealedPayload, error) {
+	query := `
+		SELECT b.id, b.tender_id, t.sealed_key, b.sealed_payload
+		FROM bids AS b
+		JOIN tenders AS t ON t.id = b.tender_id
+		WHERE b.id = ANY($1) AND b.sealed_payload IS NOT NULL
+	`
+	rows, err := dbConn.Query(ctx, "GetSealedPayloads", query, pq.Array(bidIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payloads []models.SealedPayload
+	for rows.Next() {
+		var payload models.SealedPayload
+		if err := rows.Scan(&payload.BidID, &payload.TenderID, &payload.SealedKey, &payload.Payload); err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, payload)
+	}
+	return payloads, rows.Err()
+}
+
+// GetDueSealedTenderIDs возвращает до limit запечатанных тендеров, срок подачи по которым истёк, а вскрытия ещё не было
+func GetDueSealedTenderIDs(ctx context.Context, limit int) ([]string, error) {
+	query := `
+		SELECT id FROM tenders
+		WHERE sealed AND opened_at IS NULL AND bid_deadline <= CURRENT_TIMESTAMP
+		ORDER BY bid_deadline
+		LIMIT $1
+	`
+	rows, err := dbConn.Query(ctx, "GetDueSealedTenderIDs", query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// sealedRow — зашифрованная запись предложения или его истории
+type sealedRow struct {
+	id      string
+	bidID   string
+	payload []byte
+}
+
+// OpenSealedTender вскрывает запечатанный тендер одной транзакцией: отмечает вскрытие и удаляет ключ тендера,
+// заменяет заглушки в предложениях, их истории и ценах по лотам расшифрованным reveal содержимым
+// и записывает событие в журнал. Число вскрытых предложений попадает в details события как bids.
+// Если тендер уже вскрыт, ничего не меняет и возвращает false.
+func OpenSealedTender(ctx context.Context, tenderID string, reveal func(bidID string, payload []byte) (*models.SealedBid, error), event *models.AuditEvent) (bool, error) {
+	opened := false
+	err := dbConn.BeginFunc(ctx, "OpenSealedTender", func(tx querier) error {
+		query := `
+			UPDATE tenders SET opened_at = CURRENT_TIMESTAMP, sealed_key = NULL
+			WHERE id = $1 AND sealed AND opened_at IS NULL
+		`
+		tag, err := tx.Exec(ctx, "OpenSealedTender", query, tenderID)
+		if err != nil || tag.RowsAffected() == 0 {
+			return err
+		}
+		opened = true
+
+		bids, err := querySealedRows(ctx, tx, `
+			SELECT id, id, sealed_payload FROM bids
+			WHERE tender_id = $1 AND sealed_payload IS NOT NULL
+		`, tenderID)
+		if err != nil {
+			return err
+		}
+		for _, row := range bids {
+			content, err := reveal(row.bidID, row.payload)
+			if err != nil {
+				return err
+			}
+			query := `UPDATE bids SET name = $2, description = $3, sealed_payload = NULL WHERE id = $1`
+			if _, err := tx.Exec(ctx, "OpenSealedTender", query, row.id, content.Name, content.Description); err != nil {
+				return err
+			}
+			for _, lot := range content.Lots {
+				query := `UPDATE bid_lots SET price = $3 WHERE bid_id = $1 AND lot_id = $2`
+				if _, err := tx.Exec(ctx, "OpenSealedTender", query, row.id, lot.LotID, lot.Price); err != nil {
+					return err
+				}
+			}
+		}
+
+		history, err := querySealedRows(ctx, tx, `
+			SELECT h.id, h.bid_id, h.sealed_payload
+			FROM bid_history AS h
+			JOIN bids AS b ON b.id = h.bid_id
+			WHERE b.tender_id = $1 AND h.sealed_payload IS NOT NULL
+		`, tenderID)
+		if err != nil {
+			return err
+		}
+		for _, row := range history {
+			content, err := reveal(row.bidID, row.payload)
+			if err != nil {
+				return err
+			}
+			query := `UPDATE bid_history SET name = $2, description = $3, sealed_payload = NULL WHERE id = $1`
+			if _, err := tx.Exec(ctx, "OpenSealedTender", query, row.id, content.Name, content.Description); err != nil {
+				return err
+			}
+		}
+
+		event.Details, err = json.Marshal(map[string]int{"bids": len(bids)})
+		if err != nil {
+			return err
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+	if err != nil {
+		return false, err
+	}
+	return opened, nil
+}
+
+func querySealedRows(ctx context.Context, db querier, query string, tenderID string) ([]sealedRow, error) {
+	rows, err := db.Query(ctx, "querySealedRows", query, tenderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []sealedRow
+	for rows.Next() {
+		var row sealedRow
+		if err := rows.Scan(&row.id, &row.bidID, &row.payload); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
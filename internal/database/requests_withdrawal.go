@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"tender-service/internal/models"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const withdrawalColumns = `
+	id, bid_id, version, reason, withdrawn_by, withdrawn_at, resubmit_reason, resubmitted_at, decisions_reset
+`
+
+func scanWithdrawal(row pgx.Row, withdrawal *models.BidWithdrawal) error {
+	return row.Scan(
+		&withdrawal.ID,
+		&withdrawal.BidID,
+		&withdrawal.Version,
+		&withdrawal.Reason,
+		&withdrawal.WithdrawnBy,
+		&withdrawal.WithdrawnAt,
+		&withdrawal.ResubmitReason,
+		&withdrawal.ResubmittedAt,
+		&withdrawal.DecisionsReset,
+	)
+}
+
+// WithdrawBid отменяет опубликованное предложение, записывает отзыв и событие журнала тендера одной транзакцией.
+// Если предложение изменено, по нему или по одному из его лотов уже принято окончательное решение,
+// возвращает ErrVersionConflict.
+func WithdrawBid(ctx context.Context, bid *models.Bid, withdrawal *models.BidWithdrawal, event *models.AuditEvent) error {
+	return dbConn.BeginFunc(ctx, "WithdrawBid", func(tx querier) error {
+		query := `
+			UPDATE bids SET status = $3
+			WHERE id = $1 AND version = $2 AND status = $4 AND coordination = $5
+			  AND NOT EXISTS (SELECT 1 FROM bid_lots WHERE bid_id = $1 AND coordination <> $5)
+		`
+		tag, err := tx.Exec(ctx, "WithdrawBid", query, bid.ID, bid.Version, models.Canceled, models.Published, models.Expectation)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrVersionConflict
+		}
+
+		query = `
+			INSERT INTO bid_withdrawals (bid_id, version, reason, withdrawn_by)
+			VALUES ($1, $2, $3, $4)
+			RETURNING ` + withdrawalColumns
+		if err := scanWithdrawal(tx.QueryRow(ctx, "WithdrawBid", query, bid.ID, bid.Version, withdrawal.Reason, withdrawal.WithdrawnBy), withdrawal); err != nil {
+			return err
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+}
+
+// GetOpenBidWithdrawal возвращает действующий отзыв предложения или pgx.ErrNoRows, если предложение не отозвано
+func GetOpenBidWithdrawal(ctx context.Context, bidID string) (*models.BidWithdrawal, error) {
+	var withdrawal models.BidWithdrawal
+	query := `SELECT ` + withdrawalColumns + ` FROM bid_withdrawals WHERE bid_id = $1 AND resubmitted_at IS NULL`
+	if err := scanWithdrawal(dbConn.QueryRow(ctx, "GetOpenBidWithdrawal", query, bidID), &withdrawal); err != nil {
+		return nil, err
+	}
+	return &withdrawal, nil
+}
+
+// ResubmitBid снова публикует отозванное предложение, закрывает отзыв и записывает событие журнала тендера
+// одной транзакцией.
+// При withdrawal.DecisionsReset решения, принятые по предложению до отзыва, аннулируются.
+// Если предложение изменено или уже подано повторно, возвращает ErrVersionConflict.
+func ResubmitBid(ctx context.Context, bid *models.Bid, withdrawal *models.BidWithdrawal, event *models.AuditEvent) error {
+	return dbConn.BeginFunc(ctx, "ResubmitBid", func(tx querier) error {
+		query := `UPDATE bids SET status = $3 WHERE id = $1 AND version = $2 AND status = $4`
+		tag, err := tx.Exec(ctx, "ResubmitBid", query, bid.ID, bid.Version, models.Published, models.Canceled)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrVersionConflict
+		}
+
+		query = `
+			UPDATE bid_withdrawals
+			SET resubmitted_at = CURRENT_TIMESTAMP, resubmit_reason = $2, decisions_reset = $3
+			WHERE id = $1 AND resubmitted_at IS NULL
+			RETURNING resubmitted_at
+		`
+		err = tx.QueryRow(ctx, "ResubmitBid", query, withdrawal.ID, withdrawal.ResubmitReason, withdrawal.DecisionsReset).Scan(&withdrawal.ResubmittedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrVersionConflict
+		}
+		if err != nil {
+			return err
+		}
+
+		if withdrawal.DecisionsReset {
+			query = `UPDATE bid_decisions SET invalidated_at = CURRENT_TIMESTAMP WHERE bid_id = $1 AND invalidated_at IS NULL`
+			if _, err := tx.Exec(ctx, "ResubmitBid", query, bid.ID); err != nil {
+				return err
+			}
+		}
+		return saveAuditEvent(ctx, tx, event)
+	})
+}
+
+// GetBidWithdrawals возвращает отзывы предложения от последнего к первому
+func GetBidWithdrawals(ctx context.Context, bidID string, limit, offset int) ([]models.BidWithdrawal, error) {
+	query := `
+		SELECT ` + withdrawalColumns + `
+		FROM bid_withdrawals
+		WHERE bid_id = $1
+		ORDER BY withdrawn_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := dbConn.Query(ctx, "GetBidWithdrawals", query, bidID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var withdrawals []models.BidWithdrawal
+	for rows.Next() {
+		var withdrawal models.BidWithdrawal
+		if err := scanWithdrawal(rows, &withdrawal); err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals, rows.Err()
+}
@@ -0,0 +1,120 @@
+// Package graphql отдаёт тендеры, предложения, решения и отзывы одним запросом GraphQL.
+// Вложенные поля загружаются пачками через загрузчики, права проверяются по тем же правилам, что и в REST.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	gql "github.com/graph-gophers/graphql-go"
+
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+//go:embed schema.graphql
+var schemaSource string
+
+// maxDepth ограничивает вложенность запроса, чтобы нельзя было раскрутить цикл tender → bids → tender
+const maxDepth = 8
+
+var schema = gql.MustParseSchema(schemaSource, &queryResolver{}, gql.MaxDepth(maxDepth))
+
+type ctxKey int
+
+const requestKey ctxKey = iota
+
+// request — состояние одного запроса GraphQL: пользователь и загрузчики
+type request struct {
+	viewer  *models.User
+	loaders *loaders
+}
+
+func fromContext(ctx context.Context) *request {
+	return ctx.Value(requestKey).(*request)
+}
+
+type params struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler выполняет запрос GraphQL из тела POST. Пользователь передаётся параметром ?username=.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var p params
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		writeError(w, http.StatusBadRequest, "Неверный формат запроса")
+		return
+	}
+
+	var viewer *models.User
+	if username := r.URL.Query().Get("username"); username != "" {
+		user, err := service.GetUser(ctx, username)
+		if err != nil {
+			var serr *service.Error
+			if errors.As(err, &serr) {
+				writeError(w, serr.Code, serr.Reason)
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "Внутренняя ошибка сервера")
+			return
+		}
+		viewer = user
+	}
+
+	ctx = context.WithValue(ctx, requestKey, &request{viewer: viewer, loaders: newLoaders(viewer)})
+	resp := schema.Exec(ctx, p.Query, p.OperationName, p.Variables)
+	for _, err := range resp.Errors {
+		if err.ResolverError == nil {
+			logger.FromContext(ctx).Info("graphql query rejected", "error", err.Message)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []resolverError{{code: code, reason: message}},
+	})
+}
+
+// resolverError — ошибка поля; HTTP-код, который вернул бы REST, передаётся в extensions.code
+type resolverError struct {
+	code   int
+	reason string
+}
+
+func (e resolverError) Error() string {
+	return e.reason
+}
+
+func (e resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func (e resolverError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"message": e.reason, "extensions": e.Extensions()})
+}
+
+// toError переводит ошибку сервисного слоя или базы данных в ошибку поля.
+// Подробности непредвиденных ошибок пишутся в лог и не уходят клиенту.
+func toError(ctx context.Context, err error) error {
+	var serr *service.Error
+	if errors.As(err, &serr) {
+		return resolverError{code: serr.Code, reason: serr.Reason}
+	}
+	logger.FromContext(ctx).Error("graphql resolver failed", "error", err)
+	return resolverError{code: http.StatusInternalServerError, reason: "Внутренняя ошибка сервера"}
+}
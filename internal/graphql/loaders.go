@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+// loaderWait — сколько загрузчик собирает ключи, прежде чем выполнить один запрос на всю пачку
+const loaderWait = 2 * time.Millisecond
+
+// loaders создаются на каждый запрос: кэш не переживает запрос и не смешивает данные разных пользователей.
+// Данные загружаются через сервисный слой, который отбрасывает записи, недоступные пользователю запроса.
+type loaders struct {
+	tenders       *dataloader.Loader[string, *models.TenderResponse]
+	bids          *dataloader.Loader[string, *models.BidResponse]
+	decisions     *dataloader.Loader[string, []models.UserDecision]
+	feedback      *dataloader.Loader[string, []models.Feedback]
+	lots          *dataloader.Loader[string, []models.Lot]
+	bidLots       *dataloader.Loader[string, []models.BidLot]
+	users         *dataloader.Loader[string, *models.User]
+	organizations *dataloader.Loader[string, *models.Organization]
+	responsible   *dataloader.Loader[string, bool] // отвечает ли пользователь запроса за организацию; nil без пользователя
+
+	tenderBidsMu sync.Mutex
+	tenderBids   map[page]*dataloader.Loader[string, []models.BidResponse]
+	viewer       *models.User
+}
+
+type page struct {
+	limit, offset int
+}
+
+func newLoaders(viewer *models.User) *loaders {
+	l := &loaders{
+		tenders:       newLoader(byID(forViewer(viewer, service.VisibleTenders), func(t models.TenderResponse) string { return t.ID })),
+		bids:          newLoader(byID(forViewer(viewer, service.VisibleBids), func(b models.BidResponse) string { return b.ID })),
+		decisions:     newLoader(groupBy(forViewer(viewer, service.VisibleBidDecisions), func(d models.UserDecision) string { return d.BidID })),
+		feedback:      newLoader(groupBy(forViewer(viewer, service.VisibleBidFeedback), func(f models.Feedback) string { return f.BidID })),
+		lots:          newLoader(groupBy(forViewer(viewer, service.VisibleTenderLots), func(l models.Lot) string { return l.TenderID })),
+		bidLots:       newLoader(groupBy(forViewer(viewer, service.VisibleBidLots), func(b models.BidLot) string { return b.BidID })),
+		users:         newLoader(byID(service.UsersByIDs, func(u models.User) string { return u.ID })),
+		organizations: newLoader(byID(service.OrganizationsByIDs, func(o models.Organization) string { return o.ID })),
+		tenderBids:    map[page]*dataloader.Loader[string, []models.BidResponse]{},
+		viewer:        viewer,
+	}
+	if viewer == nil {
+		return l
+	}
+	l.responsible = newLoader(func(ctx context.Context, organizationIDs []string) []*dataloader.Result[bool] {
+		results := make([]*dataloader.Result[bool], len(organizationIDs))
+		ids, err := service.ResponsibleOrganizationIDs(ctx, viewer, organizationIDs)
+		allowed := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			allowed[id] = true
+		}
+		for i, id := range organizationIDs {
+			results[i] = &dataloader.Result[bool]{Data: allowed[id], Error: err}
+		}
+		return results
+	})
+	return l
+}
+
+// bidsOfTenders возвращает загрузчик страниц предложений по тендерам; пачки собираются
+// отдельно для каждой пары limit/offset
+func (l *loaders) bidsOfTenders(limit, offset int) *dataloader.Loader[string, []models.BidResponse] {
+	l.tenderBidsMu.Lock()
+	defer l.tenderBidsMu.Unlock()
+
+	key := page{limit: limit, offset: offset}
+	loader, ok := l.tenderBids[key]
+	if !ok {
+		loader = newLoader(groupBy(func(ctx context.Context, tenderIDs []string) ([]models.BidResponse, error) {
+			return service.VisibleTenderBids(ctx, l.viewer, tenderIDs, limit, offset)
+		}, func(b models.BidResponse) string { return b.TenderID }))
+		l.tenderBids[key] = loader
+	}
+	return loader
+}
+
+// forViewer привязывает пакетную функцию сервиса к пользователю запроса
+func forViewer[V any](viewer *models.User, fetch func(ctx context.Context, viewer *models.User, ids []string) ([]V, error)) func(ctx context.Context, ids []string) ([]V, error) {
+	return func(ctx context.Context, ids []string) ([]V, error) {
+		return fetch(ctx, viewer, ids)
+	}
+}
+
+func newLoader[V any](batch dataloader.BatchFunc[string, V]) *dataloader.Loader[string, V] {
+	return dataloader.NewBatchedLoader(batch, dataloader.WithWait[string, V](loaderWait))
+}
+
+// byID строит пакетную функцию для загрузки записей по ID; отсутствующей записи соответствует nil
+func byID[V any](fetch func(ctx context.Context, ids []string) ([]V, error), id func(V) string) dataloader.BatchFunc[string, *V] {
+	return func(ctx context.Context, keys []string) []*dataloader.Result[*V] {
+		results := make([]*dataloader.Result[*V], len(keys))
+		items, err := fetch(ctx, keys)
+		found := make(map[string]*V, len(items))
+		for i := range items {
+			found[id(items[i])] = &items[i]
+		}
+		for i, key := range keys {
+			results[i] = &dataloader.Result[*V]{Data: found[key], Error: err}
+		}
+		return results
+	}
+}
+
+// groupBy строит пакетную функцию для загрузки списков, сгруппированных по ID владельца
+func groupBy[V any](fetch func(ctx context.Context, ids []string) ([]V, error), owner func(V) string) dataloader.BatchFunc[string, []V] {
+	return func(ctx context.Context, keys []string) []*dataloader.Result[[]V] {
+		results := make([]*dataloader.Result[[]V], len(keys))
+		items, err := fetch(ctx, keys)
+		grouped := make(map[string][]V, len(keys))
+		for _, item := range items {
+			grouped[owner(item)] = append(grouped[owner(item)], item)
+		}
+		for i, key := range keys {
+			results[i] = &dataloader.Result[[]V]{Data: grouped[key], Error: err}
+		}
+		return results
+	}
+}
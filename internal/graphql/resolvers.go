@@ -0,0 +1,431 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	gql "github.com/graph-gophers/graphql-go"
+
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+// pageArgs — аргументы пагинации; значения по умолчанию заданы в схеме
+type pageArgs struct {
+	Limit  int32
+	Offset int32
+}
+
+func (a pageArgs) values() (int, int) {
+	return int(a.Limit), int(a.Offset)
+}
+
+func requireViewer(ctx context.Context) (*models.User, error) {
+	viewer := fromContext(ctx).viewer
+	if viewer == nil {
+		return nil, resolverError{code: http.StatusUnauthorized, reason: "Для этого запроса нужен параметр username"}
+	}
+	return viewer, nil
+}
+
+// requireResponsible пропускает только пользователя, ответственного за организацию
+func requireResponsible(ctx context.Context, organizationID, reason string) error {
+	if _, err := requireViewer(ctx); err != nil {
+		return err
+	}
+	allowed, err := fromContext(ctx).loaders.responsible.Load(ctx, organizationID)()
+	if err != nil {
+		return toError(ctx, err)
+	}
+	if !allowed {
+		return resolverError{code: http.StatusForbidden, reason: reason}
+	}
+	return nil
+}
+
+func parseID(id gql.ID, idType string) error {
+	if _, err := uuid.Parse(string(id)); err != nil {
+		return resolverError{code: http.StatusBadRequest, reason: fmt.Sprintf("Некорректный формат %s", idType)}
+	}
+	return nil
+}
+
+type queryResolver struct{}
+
+func (queryResolver) Tenders(ctx context.Context, args struct {
+	ServiceTypes *[]string
+	pageArgs
+}) ([]*tenderResolver, error) {
+	var serviceTypes []string
+	if args.ServiceTypes != nil {
+		serviceTypes = *args.ServiceTypes
+	}
+	limit, offset := args.values()
+	tenders, err := service.ListTenders(ctx, serviceTypes, limit, offset)
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	return tenderResolvers(tenders), nil
+}
+
+func (queryResolver) MyTenders(ctx context.Context, args pageArgs) ([]*tenderResolver, error) {
+	viewer, err := requireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := args.values()
+	tenders, err := service.ListUserTenders(ctx, viewer.Username, limit, offset)
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	return tenderResolvers(tenders), nil
+}
+
+// Tender возвращает тендер по правилам доступа REST; тендер, который пользователь не видит, не найден
+func (queryResolver) Tender(ctx context.Context, args struct{ ID gql.ID }) (*tenderResolver, error) {
+	if err := parseID(args.ID, "ID тендера"); err != nil {
+		return nil, err
+	}
+	tender, err := fromContext(ctx).loaders.tenders.Load(ctx, string(args.ID))()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	if tender == nil {
+		return nil, resolverError{code: http.StatusNotFound, reason: fmt.Sprintf("Тендер с ID %s не найден", args.ID)}
+	}
+	return &tenderResolver{tender}, nil
+}
+
+func (queryResolver) MyBids(ctx context.Context, args pageArgs) ([]*bidResolver, error) {
+	viewer, err := requireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := args.values()
+	bids, err := service.ListUserBids(ctx, viewer.Username, limit, offset)
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	return bidResolvers(bids), nil
+}
+
+// Bid возвращает предложение автору и ответственным за организацию тендера;
+// для остальных пользователей предложение не найдено
+func (queryResolver) Bid(ctx context.Context, args struct{ ID gql.ID }) (*bidResolver, error) {
+	if _, err := requireViewer(ctx); err != nil {
+		return nil, err
+	}
+	if err := parseID(args.ID, "ID предложения"); err != nil {
+		return nil, err
+	}
+	bid, err := fromContext(ctx).loaders.bids.Load(ctx, string(args.ID))()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	if bid == nil {
+		return nil, resolverError{code: http.StatusNotFound, reason: fmt.Sprintf("Предложение с указанным ID %s не найдено", args.ID)}
+	}
+	return &bidResolver{bid}, nil
+}
+
+func (queryResolver) Organization(ctx context.Context, args struct{ ID gql.ID }) (*organizationResolver, error) {
+	if err := parseID(args.ID, "ID организации"); err != nil {
+		return nil, err
+	}
+	organization, err := fromContext(ctx).loaders.organizations.Load(ctx, string(args.ID))()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	if organization == nil {
+		return nil, resolverError{code: http.StatusNotFound, reason: "Организация не найдена"}
+	}
+	return &organizationResolver{organization}, nil
+}
+
+type tenderResolver struct {
+	tender *models.TenderResponse
+}
+
+func tenderResolvers(tenders []models.TenderResponse) []*tenderResolver {
+	resolvers := make([]*tenderResolver, len(tenders))
+	for i := range tenders {
+		resolvers[i] = &tenderResolver{&tenders[i]}
+	}
+	return resolvers
+}
+
+func (r *tenderResolver) ID() gql.ID          { return gql.ID(r.tender.ID) }
+func (r *tenderResolver) Name() string        { return r.tender.Name }
+func (r *tenderResolver) Description() string { return r.tender.Description }
+func (r *tenderResolver) ServiceType() string { return string(r.tender.ServiceType) }
+func (r *tenderResolver) Status() string      { return string(r.tender.Status) }
+func (r *tenderResolver) Version() int32      { return int32(r.tender.Version) }
+func (r *tenderResolver) CreatedAt() string   { return r.tender.CreatedAt }
+func (r *tenderResolver) Sealed() bool        { return r.tender.Sealed }
+func (r *tenderResolver) Visibility() string  { return string(r.tender.Visibility) }
+
+func (r *tenderResolver) BidDeadline() *string {
+	if r.tender.BidDeadline == "" {
+		return nil
+	}
+	return &r.tender.BidDeadline
+}
+
+func (r *tenderResolver) OpenedAt() *string {
+	if r.tender.OpenedAt == "" {
+		return nil
+	}
+	return &r.tender.OpenedAt
+}
+
+func (r *tenderResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	organization, err := fromContext(ctx).loaders.organizations.Load(ctx, r.tender.OrganizationID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	if organization == nil {
+		return nil, nil
+	}
+	return &organizationResolver{organization}, nil
+}
+
+func (r *tenderResolver) Bids(ctx context.Context, args pageArgs) ([]*bidResolver, error) {
+	if err := requireResponsible(ctx, r.tender.OrganizationID, "Недостаточно прав для выполнения действия"); err != nil {
+		return nil, err
+	}
+	limit, offset := args.values()
+	if limit < 0 || offset < 0 {
+		return nil, resolverError{code: http.StatusBadRequest, reason: "Некорректные параметры пагинации"}
+	}
+	if limit == 0 {
+		limit = service.DefaultLimit
+	}
+	bids, err := fromContext(ctx).loaders.bidsOfTenders(limit, offset).Load(ctx, r.tender.ID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	return bidResolvers(bids), nil
+}
+
+// Lots повторяет правила GET /api/tenders/{tenderId}/lots: лоты неопубликованного тендера
+// видны только ответственным за его организацию
+func (r *tenderResolver) Lots(ctx context.Context) ([]*lotResolver, error) {
+	if r.tender.Status != models.Published {
+		if err := requireResponsible(ctx, r.tender.OrganizationID, "Недостаточно прав для просмотра лотов тендера"); err != nil {
+			return nil, err
+		}
+	}
+	lots, err := fromContext(ctx).loaders.lots.Load(ctx, r.tender.ID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	resolvers := make([]*lotResolver, len(lots))
+	for i := range lots {
+		resolvers[i] = &lotResolver{&lots[i]}
+	}
+	return resolvers, nil
+}
+
+type lotResolver struct {
+	lot *models.Lot
+}
+
+func (r *lotResolver) ID() gql.ID          { return gql.ID(r.lot.ID) }
+func (r *lotResolver) Name() string        { return r.lot.Name }
+func (r *lotResolver) Description() string { return r.lot.Description }
+func (r *lotResolver) Quantity() int32     { return int32(r.lot.Quantity) }
+func (r *lotResolver) Budget() float64     { return r.lot.Budget }
+func (r *lotResolver) AwardedBidID() *gql.ID {
+	return optionalID(r.lot.AwardedBidID)
+}
+
+func optionalID(id string) *gql.ID {
+	if id == "" {
+		return nil
+	}
+	gid := gql.ID(id)
+	return &gid
+}
+
+type bidResolver struct {
+	bid *models.BidResponse
+}
+
+func bidResolvers(bids []models.BidResponse) []*bidResolver {
+	resolvers := make([]*bidResolver, len(bids))
+	for i := range bids {
+		resolvers[i] = &bidResolver{&bids[i]}
+	}
+	return resolvers
+}
+
+func (r *bidResolver) ID() gql.ID          { return gql.ID(r.bid.ID) }
+func (r *bidResolver) Name() string        { return r.bid.Name }
+func (r *bidResolver) Description() string { return r.bid.Description }
+func (r *bidResolver) Status() string      { return string(r.bid.Status) }
+func (r *bidResolver) AuthorType() string  { return string(r.bid.AuthorType) }
+func (r *bidResolver) Version() int32      { return int32(r.bid.Version) }
+func (r *bidResolver) CreatedAt() string   { return r.bid.CreatedAt }
+func (r *bidResolver) Sealed() bool        { return r.bid.Sealed }
+
+func (r *bidResolver) Tender(ctx context.Context) (*tenderResolver, error) {
+	tender, err := r.loadTender(ctx)
+	if err != nil || tender == nil {
+		return nil, err
+	}
+	return &tenderResolver{tender}, nil
+}
+
+func (r *bidResolver) Author(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.bid.AuthorID)
+}
+
+func (r *bidResolver) Decisions(ctx context.Context) ([]*decisionResolver, error) {
+	if err := r.requireTenderResponsible(ctx); err != nil {
+		return nil, err
+	}
+	decisions, err := fromContext(ctx).loaders.decisions.Load(ctx, r.bid.ID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	resolvers := make([]*decisionResolver, len(decisions))
+	for i := range decisions {
+		resolvers[i] = &decisionResolver{&decisions[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *bidResolver) Feedback(ctx context.Context) ([]*feedbackResolver, error) {
+	if err := r.requireTenderResponsible(ctx); err != nil {
+		return nil, err
+	}
+	feedback, err := fromContext(ctx).loaders.feedback.Load(ctx, r.bid.ID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	resolvers := make([]*feedbackResolver, len(feedback))
+	for i := range feedback {
+		resolvers[i] = &feedbackResolver{&feedback[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *bidResolver) Lots(ctx context.Context) ([]*bidLotResolver, error) {
+	bidLots, err := fromContext(ctx).loaders.bidLots.Load(ctx, r.bid.ID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	resolvers := make([]*bidLotResolver, len(bidLots))
+	for i := range bidLots {
+		resolvers[i] = &bidLotResolver{&bidLots[i]}
+	}
+	return resolvers, nil
+}
+
+// loadTender возвращает тендер предложения или nil, если пользователь его не видит
+func (r *bidResolver) loadTender(ctx context.Context) (*models.TenderResponse, error) {
+	tender, err := fromContext(ctx).loaders.tenders.Load(ctx, r.bid.TenderID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	return tender, nil
+}
+
+func (r *bidResolver) requireTenderResponsible(ctx context.Context) error {
+	tender, err := r.loadTender(ctx)
+	if err != nil {
+		return err
+	}
+	if tender == nil {
+		return resolverError{code: http.StatusNotFound, reason: fmt.Sprintf("Тендер с ID %s не найден", r.bid.TenderID)}
+	}
+	return requireResponsible(ctx, tender.OrganizationID, "Недостаточно прав для просмотра решений и отзывов по предложению")
+}
+
+type bidLotResolver struct {
+	bidLot *models.BidLot
+}
+
+func (r *bidLotResolver) LotID() gql.ID        { return gql.ID(r.bidLot.LotID) }
+func (r *bidLotResolver) Price() float64       { return r.bidLot.Price }
+func (r *bidLotResolver) Coordination() string { return string(r.bidLot.Сoordination) }
+
+type decisionResolver struct {
+	decision *models.UserDecision
+}
+
+func (r *decisionResolver) ID() gql.ID        { return gql.ID(r.decision.ID) }
+func (r *decisionResolver) Decision() string  { return string(r.decision.Decision) }
+func (r *decisionResolver) LotID() *gql.ID    { return optionalID(r.decision.LotID) }
+func (r *decisionResolver) BidVersion() int32 { return int32(r.decision.BidVersion) }
+func (r *decisionResolver) CreatedAt() string { return r.decision.Created_at.Format(time.RFC3339) }
+
+func (r *decisionResolver) Comment() *string {
+	if r.decision.Comment == "" {
+		return nil
+	}
+	return &r.decision.Comment
+}
+
+func (r *decisionResolver) User(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.decision.UserID)
+}
+
+type feedbackResolver struct {
+	feedback *models.Feedback
+}
+
+func (r *feedbackResolver) ID() gql.ID          { return gql.ID(r.feedback.ID) }
+func (r *feedbackResolver) Description() string { return r.feedback.BidFeedback }
+func (r *feedbackResolver) CreatedAt() string   { return r.feedback.CreatedAt.Format(time.RFC3339) }
+
+func (r *feedbackResolver) Ratings() *feedbackRatingsResolver {
+	if r.feedback.Ratings == nil {
+		return nil
+	}
+	return &feedbackRatingsResolver{r.feedback.Ratings}
+}
+
+func (r *feedbackResolver) Author(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.feedback.UserID)
+}
+
+type feedbackRatingsResolver struct {
+	ratings *models.FeedbackRatings
+}
+
+func (r *feedbackRatingsResolver) Quality() int32       { return int32(r.ratings.Quality) }
+func (r *feedbackRatingsResolver) Timeliness() int32    { return int32(r.ratings.Timeliness) }
+func (r *feedbackRatingsResolver) Communication() int32 { return int32(r.ratings.Communication) }
+
+type userResolver struct {
+	user *models.User
+}
+
+func loadUser(ctx context.Context, userID string) (*userResolver, error) {
+	user, err := fromContext(ctx).loaders.users.Load(ctx, userID)()
+	if err != nil {
+		return nil, toError(ctx, err)
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return &userResolver{user}, nil
+}
+
+func (r *userResolver) ID() gql.ID        { return gql.ID(r.user.ID) }
+func (r *userResolver) Username() string  { return r.user.Username }
+func (r *userResolver) FirstName() string { return r.user.FirstName }
+func (r *userResolver) LastName() string  { return r.user.LastName }
+
+type organizationResolver struct {
+	organization *models.Organization
+}
+
+func (r *organizationResolver) ID() gql.ID          { return gql.ID(r.organization.ID) }
+func (r *organizationResolver) Name() string        { return r.organization.Name }
+func (r *organizationResolver) Description() string { return r.organization.Description }
+func (r *organizationResolver) Type() string        { return string(r.organization.Type) }
@@ -0,0 +1,259 @@
+package grpcserver
+
+import (
+	"context"
+
+	tenderv1 "tender-service/api/proto/tender/v1"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+type bidServer struct {
+	tenderv1.UnimplementedBidServiceServer
+}
+
+func (bidServer) CreateBid(ctx context.Context, req *tenderv1.CreateBidRequest) (*tenderv1.Bid, error) {
+	bid, err := service.CreateBid(ctx, &models.BidRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		TenderID:    req.GetTenderId(),
+		AuthorType:  authorTypes[req.GetAuthorType()],
+		AuthorID:    req.GetAuthorId(),
+		Lots:        bidLotsFromProto(req.GetLots()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) ListUserBids(ctx context.Context, req *tenderv1.ListUserBidsRequest) (*tenderv1.ListBidsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	bids, err := service.ListUserBids(ctx, req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidsToProto(bids), nil
+}
+
+func (bidServer) ListTenderBids(ctx context.Context, req *tenderv1.ListTenderBidsRequest) (*tenderv1.ListBidsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	bids, err := service.ListTenderBids(ctx, req.GetTenderId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidsToProto(bids), nil
+}
+
+func (bidServer) GetBidStatus(ctx context.Context, req *tenderv1.GetBidStatusRequest) (*tenderv1.GetBidStatusResponse, error) {
+	bidStatus, version, err := service.GetBidStatus(ctx, req.GetBidId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &tenderv1.GetBidStatusResponse{Status: bidStatusesToProto[bidStatus], Version: int32(version)}, nil
+}
+
+func (bidServer) UpdateBidStatus(ctx context.Context, req *tenderv1.UpdateBidStatusRequest) (*tenderv1.Bid, error) {
+	bid, err := service.UpdateBidStatus(ctx, req.GetBidId(), bidStatuses[req.GetStatus()], req.GetUsername(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) SubmitBidDecision(ctx context.Context, req *tenderv1.SubmitBidDecisionRequest) (*tenderv1.Bid, error) {
+	bid, err := service.SubmitBidDecision(ctx, req.GetBidId(), decisions[req.GetDecision()], req.GetUsername(), req.GetLotId(), req.GetComment())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) EditBid(ctx context.Context, req *tenderv1.EditBidRequest) (*tenderv1.Bid, error) {
+	bid, err := service.EditBid(ctx, req.GetBidId(), req.GetUsername(), &models.BidEditRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}, int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) RollbackBid(ctx context.Context, req *tenderv1.RollbackBidRequest) (*tenderv1.Bid, error) {
+	bid, err := service.RollbackBid(ctx, req.GetBidId(), int(req.GetVersion()), req.GetUsername(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) SubmitBidFeedback(ctx context.Context, req *tenderv1.SubmitBidFeedbackRequest) (*tenderv1.Bid, error) {
+	bid, err := service.SubmitBidFeedback(ctx, req.GetBidId(), req.GetUsername(), &models.FeedbackRequest{
+		Description: req.GetFeedback(),
+		Ratings:     ratingsFromProto(req.GetRatings()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) GetReputation(ctx context.Context, req *tenderv1.GetReputationRequest) (*tenderv1.Reputation, error) {
+	reputation, err := service.GetReputation(ctx, authorTypes[req.GetAuthorType()], req.GetAuthorId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return reputationToProto(reputation), nil
+}
+
+func (bidServer) ListBidReviews(ctx context.Context, req *tenderv1.ListBidReviewsRequest) (*tenderv1.ListBidReviewsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	reviews, err := service.ListBidReviews(ctx, req.GetTenderId(), req.GetAuthorUsername(), req.GetRequesterUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return reviewsToProto(reviews), nil
+}
+
+func (bidServer) SubmitBidScores(ctx context.Context, req *tenderv1.SubmitBidScoresRequest) (*tenderv1.BidEvaluation, error) {
+	evaluation, err := service.SubmitBidScores(ctx, req.GetBidId(), req.GetUsername(), &models.BidScoresRequest{
+		Scores: bidScoresFromProto(req.GetScores()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return evaluationToProto(evaluation), nil
+}
+
+func (bidServer) SubmitAuctionPrice(ctx context.Context, req *tenderv1.SubmitAuctionPriceRequest) (*tenderv1.AuctionPosition, error) {
+	position, err := service.SubmitAuctionPrice(ctx, req.GetBidId(), req.GetUsername(), &models.AuctionPriceRequest{
+		Price: req.GetPrice(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return auctionPositionToProto(position), nil
+}
+
+func (bidServer) GetAuctionPosition(ctx context.Context, req *tenderv1.GetAuctionPositionRequest) (*tenderv1.AuctionPosition, error) {
+	position, err := service.GetAuctionPosition(ctx, req.GetBidId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return auctionPositionToProto(position), nil
+}
+
+func (bidServer) WithdrawBid(ctx context.Context, req *tenderv1.WithdrawBidRequest) (*tenderv1.Bid, error) {
+	bid, err := service.WithdrawBid(ctx, req.GetBidId(), req.GetUsername(), &models.BidWithdrawalRequest{
+		Reason: req.GetReason(),
+	}, int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) ResubmitBid(ctx context.Context, req *tenderv1.ResubmitBidRequest) (*tenderv1.Bid, error) {
+	bid, err := service.ResubmitBid(ctx, req.GetBidId(), req.GetUsername(), &models.BidWithdrawalRequest{
+		Reason: req.GetReason(),
+	}, int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) ListBidWithdrawals(ctx context.Context, req *tenderv1.ListBidWithdrawalsRequest) (*tenderv1.ListBidWithdrawalsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	withdrawals, err := service.ListBidWithdrawals(ctx, req.GetBidId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return withdrawalsToProto(withdrawals), nil
+}
+
+func (bidServer) RevokeBidDecision(ctx context.Context, req *tenderv1.RevokeBidDecisionRequest) (*tenderv1.Bid, error) {
+	bid, err := service.RevokeBidDecision(ctx, req.GetBidId(), req.GetUsername(), req.GetLotId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return bidToProto(bid), nil
+}
+
+func (bidServer) ListBidDecisions(ctx context.Context, req *tenderv1.ListBidDecisionsRequest) (*tenderv1.ListBidDecisionsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	decisions, err := service.ListBidDecisions(ctx, req.GetBidId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return decisionsToProto(decisions), nil
+}
+
+func (bidServer) ListBidFeedback(ctx context.Context, req *tenderv1.ListBidFeedbackRequest) (*tenderv1.ListBidReviewsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	reviews, err := service.ListBidFeedback(ctx, req.GetBidId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return reviewsToProto(reviews), nil
+}
+
+func (bidServer) ReplyToFeedback(ctx context.Context, req *tenderv1.ReplyToFeedbackRequest) (*tenderv1.FeedbackReply, error) {
+	reply, err := service.ReplyToFeedback(ctx, req.GetFeedbackId(), req.GetUsername(), &models.FeedbackReplyRequest{
+		Text: req.GetText(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return feedbackReplyToProto(reply), nil
+}
+
+func (bidServer) ReportFeedback(ctx context.Context, req *tenderv1.ReportFeedbackRequest) (*tenderv1.FeedbackReport, error) {
+	report, err := service.ReportFeedback(ctx, req.GetFeedbackId(), req.GetReplyId(), req.GetUsername(), &models.FeedbackReportRequest{
+		Reason: req.GetReason(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &tenderv1.FeedbackReport{
+		Id:         report.ID,
+		FeedbackId: report.FeedbackID,
+		ReplyId:    report.ReplyID,
+		Reason:     report.Reason,
+		CreatedAt:  parseTime(report.CreatedAt),
+	}, nil
+}
+
+func (bidServer) HideFeedback(ctx context.Context, req *tenderv1.ModerateFeedbackRequest) (*tenderv1.ModerationItem, error) {
+	item, err := service.HideFeedback(ctx, req.GetFeedbackId(), req.GetReplyId(), req.GetUsername(), &models.ModerationRequest{
+		Reason: req.GetReason(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return moderationItemToProto(item), nil
+}
+
+func (bidServer) RestoreFeedback(ctx context.Context, req *tenderv1.ModerateFeedbackRequest) (*tenderv1.ModerationItem, error) {
+	item, err := service.RestoreFeedback(ctx, req.GetFeedbackId(), req.GetReplyId(), req.GetUsername(), &models.ModerationRequest{
+		Reason: req.GetReason(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return moderationItemToProto(item), nil
+}
+
+func (bidServer) ListModerationQueue(ctx context.Context, req *tenderv1.ListModerationQueueRequest) (*tenderv1.ListModerationQueueResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	items, err := service.ListModerationQueue(ctx, req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &tenderv1.ListModerationQueueResponse{Items: make([]*tenderv1.ModerationItem, len(items))}
+	for i := range items {
+		resp.Items[i] = moderationItemToProto(&items[i])
+	}
+	return resp, nil
+}
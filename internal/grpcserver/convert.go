@@ -0,0 +1,566 @@
+package grpcserver
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tenderv1 "tender-service/api/proto/tender/v1"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+// Значения перечислений, отсутствующие в таблицах, передаются сервисному слою пустой строкой
+// и отклоняются его проверками с теми же сообщениями, что и в REST.
+
+var serviceTypes = map[tenderv1.ServiceType]models.ServiceType{
+	tenderv1.ServiceType_SERVICE_TYPE_CONSTRUCTION: models.Construction,
+	tenderv1.ServiceType_SERVICE_TYPE_DELIVERY:     models.Delivery,
+	tenderv1.ServiceType_SERVICE_TYPE_MANUFACTURE:  models.Manufacture,
+}
+
+var tenderStatuses = map[tenderv1.TenderStatus]models.Status{
+	tenderv1.TenderStatus_TENDER_STATUS_CREATED:   models.Created,
+	tenderv1.TenderStatus_TENDER_STATUS_PUBLISHED: models.Published,
+	tenderv1.TenderStatus_TENDER_STATUS_CLOSED:    models.Closed,
+}
+
+var bidStatuses = map[tenderv1.BidStatus]models.Status{
+	tenderv1.BidStatus_BID_STATUS_CREATED:   models.Created,
+	tenderv1.BidStatus_BID_STATUS_PUBLISHED: models.Published,
+	tenderv1.BidStatus_BID_STATUS_CANCELED:  models.Canceled,
+	tenderv1.BidStatus_BID_STATUS_CLOSED:    models.Closed,
+}
+
+var authorTypes = map[tenderv1.AuthorType]models.AuthorType{
+	tenderv1.AuthorType_AUTHOR_TYPE_ORGANIZATION: models.AuthorTypeOrganization,
+	tenderv1.AuthorType_AUTHOR_TYPE_USER:         models.AuthorTypeUser,
+}
+
+var decisions = map[tenderv1.Decision]models.Сoordination{
+	tenderv1.Decision_DECISION_APPROVED: models.Approved,
+	tenderv1.Decision_DECISION_REJECTED: models.Rejected,
+}
+
+var coordinations = map[tenderv1.Coordination]models.Сoordination{
+	tenderv1.Coordination_COORDINATION_EXPECTATION:          models.Expectation,
+	tenderv1.Coordination_COORDINATION_APPROVED:             models.Approved,
+	tenderv1.Coordination_COORDINATION_REJECTED:             models.Rejected,
+	tenderv1.Coordination_COORDINATION_REJECTED_BY_CONFLICT: models.RejectedByConflict,
+}
+
+var awardStatuses = map[tenderv1.AwardStatus]models.AwardStatus{
+	tenderv1.AwardStatus_AWARD_STATUS_PENDING_SIGNATURE: models.AwardPendingSignature,
+	tenderv1.AwardStatus_AWARD_STATUS_ACTIVE:            models.AwardActive,
+	tenderv1.AwardStatus_AWARD_STATUS_COMPLETED:         models.AwardCompleted,
+	tenderv1.AwardStatus_AWARD_STATUS_TERMINATED:        models.AwardTerminated,
+}
+
+var visibilities = map[tenderv1.TenderVisibility]models.TenderVisibility{
+	tenderv1.TenderVisibility_TENDER_VISIBILITY_PUBLIC:      models.VisibilityPublic,
+	tenderv1.TenderVisibility_TENDER_VISIBILITY_INVITE_ONLY: models.VisibilityInviteOnly,
+}
+
+var invitationStatuses = map[tenderv1.InvitationStatus]models.InvitationStatus{
+	tenderv1.InvitationStatus_INVITATION_STATUS_PENDING:  models.InvitationPending,
+	tenderv1.InvitationStatus_INVITATION_STATUS_ACCEPTED: models.InvitationAccepted,
+	tenderv1.InvitationStatus_INVITATION_STATUS_DECLINED: models.InvitationDeclined,
+}
+
+// reverse строит обратную таблицу для перевода значений модели в перечисления protobuf
+func reverse[K comparable, V comparable](m map[K]V) map[V]K {
+	r := make(map[V]K, len(m))
+	for k, v := range m {
+		r[v] = k
+	}
+	return r
+}
+
+var (
+	serviceTypesToProto       = reverse(serviceTypes)
+	tenderStatusesToProto     = reverse(tenderStatuses)
+	bidStatusesToProto        = reverse(bidStatuses)
+	authorTypesToProto        = reverse(authorTypes)
+	coordinationsToProto      = reverse(coordinations)
+	decisionTypesToProto      = reverse(decisions)
+	awardStatusesToProto      = reverse(awardStatuses)
+	visibilitiesToProto       = reverse(visibilities)
+	invitationStatusesToProto = reverse(invitationStatuses)
+)
+
+func pageParams(page *tenderv1.Page) (int, int) {
+	return int(page.GetLimit()), int(page.GetOffset())
+}
+
+// parseTime разбирает дату в формате REST-ответа; пустая или некорректная дата даёт nil
+func parseTime(value string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func tenderToProto(tender *models.TenderResponse) *tenderv1.Tender {
+	return &tenderv1.Tender{
+		Id:             tender.ID,
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    serviceTypesToProto[tender.ServiceType],
+		Status:         tenderStatusesToProto[tender.Status],
+		OrganizationId: tender.OrganizationID,
+		Version:        int32(tender.Version),
+		CreatedAt:      parseTime(tender.CreatedAt),
+		BidDeadline:    parseTime(tender.BidDeadline),
+		Sealed:         tender.Sealed,
+		OpenedAt:       parseTime(tender.OpenedAt),
+		Visibility:     visibilitiesToProto[tender.Visibility],
+	}
+}
+
+func tendersToProto(tenders []models.TenderResponse) *tenderv1.ListTendersResponse {
+	resp := &tenderv1.ListTendersResponse{Tenders: make([]*tenderv1.Tender, len(tenders))}
+	for i := range tenders {
+		resp.Tenders[i] = tenderToProto(&tenders[i])
+	}
+	return resp
+}
+
+func bidToProto(bid *models.BidResponse) *tenderv1.Bid {
+	return &tenderv1.Bid{
+		Id:               bid.ID,
+		Name:             bid.Name,
+		Description:      bid.Description,
+		Status:           bidStatusesToProto[bid.Status],
+		TenderId:         bid.TenderID,
+		AuthorType:       authorTypesToProto[bid.AuthorType],
+		AuthorId:         bid.AuthorID,
+		Version:          int32(bid.Version),
+		CreatedAt:        parseTime(bid.CreatedAt),
+		Lots:             bidLotsToProto(bid.Lots),
+		Sealed:           bid.Sealed,
+		AuthorReputation: reputationToProto(bid.AuthorReputation),
+	}
+}
+
+func bidLotsToProto(bidLots []models.BidLotResponse) []*tenderv1.BidLot {
+	if len(bidLots) == 0 {
+		return nil
+	}
+	resp := make([]*tenderv1.BidLot, len(bidLots))
+	for i, bidLot := range bidLots {
+		resp[i] = &tenderv1.BidLot{
+			LotId:        bidLot.LotID,
+			Price:        bidLot.Price,
+			Coordination: coordinationsToProto[bidLot.Coordination],
+		}
+	}
+	return resp
+}
+
+func lotsToProto(lots []models.LotResponse) *tenderv1.ListTenderLotsResponse {
+	resp := &tenderv1.ListTenderLotsResponse{Lots: make([]*tenderv1.Lot, len(lots))}
+	for i, lot := range lots {
+		resp.Lots[i] = &tenderv1.Lot{
+			Id:           lot.ID,
+			Name:         lot.Name,
+			Description:  lot.Description,
+			Quantity:     int32(lot.Quantity),
+			Budget:       lot.Budget,
+			AwardedBidId: lot.AwardedBidID,
+		}
+	}
+	return resp
+}
+
+// timeFromProto возвращает nil для незаданного времени
+func timeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.AsTime()
+	return &value
+}
+
+func clarificationToProto(clarification *models.ClarificationResponse) *tenderv1.Clarification {
+	return &tenderv1.Clarification{
+		Id:         clarification.ID,
+		Question:   clarification.Question,
+		Answer:     clarification.Answer,
+		Mine:       clarification.Mine,
+		CreatedAt:  parseTime(clarification.CreatedAt),
+		AnsweredAt: parseTime(clarification.AnsweredAt),
+	}
+}
+
+func clarificationsToProto(clarifications []models.ClarificationResponse) *tenderv1.ListClarificationsResponse {
+	resp := &tenderv1.ListClarificationsResponse{Clarifications: make([]*tenderv1.Clarification, len(clarifications))}
+	for i := range clarifications {
+		resp.Clarifications[i] = clarificationToProto(&clarifications[i])
+	}
+	return resp
+}
+
+func criteriaToProto(criteria []models.CriterionResponse) *tenderv1.ListEvaluationCriteriaResponse {
+	resp := &tenderv1.ListEvaluationCriteriaResponse{Criteria: make([]*tenderv1.Criterion, len(criteria))}
+	for i, criterion := range criteria {
+		resp.Criteria[i] = &tenderv1.Criterion{
+			Id:          criterion.ID,
+			Name:        criterion.Name,
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+		}
+	}
+	return resp
+}
+
+func criteriaFromProto(criteria []*tenderv1.Criterion) []models.CriterionRequest {
+	var req []models.CriterionRequest
+	for _, criterion := range criteria {
+		req = append(req, models.CriterionRequest{
+			Name:        criterion.GetName(),
+			Description: criterion.GetDescription(),
+			Weight:      criterion.GetWeight(),
+		})
+	}
+	return req
+}
+
+func bidScoresFromProto(scores []*tenderv1.BidScore) []models.BidScoreRequest {
+	var req []models.BidScoreRequest
+	for _, score := range scores {
+		req = append(req, models.BidScoreRequest{CriterionID: score.GetCriterionId(), Score: int(score.GetScore())})
+	}
+	return req
+}
+
+func evaluationToProto(evaluation *models.BidEvaluationResponse) *tenderv1.BidEvaluation {
+	resp := &tenderv1.BidEvaluation{BidId: evaluation.BidID, Scores: make([]*tenderv1.BidScore, len(evaluation.Scores))}
+	for i, score := range evaluation.Scores {
+		resp.Scores[i] = &tenderv1.BidScore{CriterionId: score.CriterionID, Score: int32(score.Score)}
+	}
+	return resp
+}
+
+func rankingToProto(ranking []models.RankingEntry) *tenderv1.GetBidRankingResponse {
+	resp := &tenderv1.GetBidRankingResponse{Entries: make([]*tenderv1.RankingEntry, len(ranking))}
+	for i, entry := range ranking {
+		averages := make([]*tenderv1.CriterionAverage, len(entry.Criteria))
+		for j, average := range entry.Criteria {
+			averages[j] = &tenderv1.CriterionAverage{CriterionId: average.CriterionID, Average: average.Average}
+		}
+		resp.Entries[i] = &tenderv1.RankingEntry{
+			Rank:         int32(entry.Rank),
+			BidId:        entry.BidID,
+			BidName:      entry.BidName,
+			AuthorType:   authorTypesToProto[entry.AuthorType],
+			AuthorId:     entry.AuthorID,
+			Status:       bidStatusesToProto[entry.Status],
+			Coordination: coordinationsToProto[entry.Coordination],
+			Score:        entry.Score,
+			Evaluators:   int32(entry.Evaluators),
+			Criteria:     averages,
+		}
+	}
+	return resp
+}
+
+func auditEventsToProto(events []models.AuditEventResponse) *tenderv1.ListAuditEventsResponse {
+	resp := &tenderv1.ListAuditEventsResponse{Events: make([]*tenderv1.AuditEvent, len(events))}
+	for i, event := range events {
+		resp.Events[i] = &tenderv1.AuditEvent{
+			Id:         event.ID,
+			EntityType: event.EntityType,
+			EntityId:   event.EntityID,
+			Action:     string(event.Action),
+			ActorId:    event.ActorID,
+			Details:    string(event.Details),
+			CreatedAt:  parseTime(event.CreatedAt),
+		}
+	}
+	return resp
+}
+
+// auctionFromProto возвращает nil, если торги в запросе не заданы
+func auctionFromProto(auction *tenderv1.AuctionSettings) *models.AuctionRequest {
+	if auction == nil {
+		return nil
+	}
+	req := &models.AuctionRequest{
+		MinDecrement: auction.GetMinDecrement(),
+		Extension:    int(auction.GetExtensionSeconds()),
+	}
+	// Незаданное время остаётся нулевым, чтобы сервис отклонил запрос, а не начал торги с 1970 года
+	if auction.GetStartsAt() != nil {
+		req.StartsAt = auction.GetStartsAt().AsTime()
+	}
+	if auction.GetEndsAt() != nil {
+		req.EndsAt = auction.GetEndsAt().AsTime()
+	}
+	return req
+}
+
+func auctionToProto(auction *models.AuctionResponse) *tenderv1.Auction {
+	resp := &tenderv1.Auction{
+		TenderId:         auction.TenderID,
+		StartsAt:         parseTime(auction.StartsAt),
+		EndsAt:           parseTime(auction.EndsAt),
+		MinDecrement:     auction.MinDecrement,
+		ExtensionSeconds: int32(auction.Extension),
+		Status:           string(auction.Status),
+		ClosedAt:         parseTime(auction.ClosedAt),
+		AwardedBidId:     auction.AwardedBidID,
+		Entries:          make([]*tenderv1.AuctionEntry, len(auction.Entries)),
+	}
+	for i, entry := range auction.Entries {
+		resp.Entries[i] = &tenderv1.AuctionEntry{
+			Rank:       int32(entry.Rank),
+			BidId:      entry.BidID,
+			AuthorType: authorTypesToProto[entry.AuthorType],
+			AuthorId:   entry.AuthorID,
+			Price:      entry.Price,
+			UpdatedAt:  parseTime(entry.UpdatedAt),
+		}
+	}
+	return resp
+}
+
+func auctionPositionToProto(position *models.AuctionPositionResponse) *tenderv1.AuctionPosition {
+	return &tenderv1.AuctionPosition{
+		BidId:        position.BidID,
+		Price:        position.Price,
+		Rank:         int32(position.Rank),
+		Participants: int32(position.Participants),
+		BestPrice:    position.BestPrice,
+		Auction:      auctionToProto(&position.Auction),
+	}
+}
+
+func lotsFromProto(lots []*tenderv1.Lot) []models.LotRequest {
+	var req []models.LotRequest
+	for _, lot := range lots {
+		req = append(req, models.LotRequest{
+			Name:        lot.GetName(),
+			Description: lot.GetDescription(),
+			Quantity:    int(lot.GetQuantity()),
+			Budget:      lot.GetBudget(),
+		})
+	}
+	return req
+}
+
+func bidLotsFromProto(bidLots []*tenderv1.BidLot) []models.BidLotRequest {
+	var req []models.BidLotRequest
+	for _, bidLot := range bidLots {
+		req = append(req, models.BidLotRequest{LotID: bidLot.GetLotId(), Price: bidLot.GetPrice()})
+	}
+	return req
+}
+
+func bidsToProto(bids []models.BidResponse) *tenderv1.ListBidsResponse {
+	resp := &tenderv1.ListBidsResponse{Bids: make([]*tenderv1.Bid, len(bids))}
+	for i := range bids {
+		resp.Bids[i] = bidToProto(&bids[i])
+	}
+	return resp
+}
+
+func reviewsToProto(reviews []models.FeedbackResponse) *tenderv1.ListBidReviewsResponse {
+	resp := &tenderv1.ListBidReviewsResponse{Reviews: make([]*tenderv1.Feedback, len(reviews))}
+	for i, review := range reviews {
+		resp.Reviews[i] = &tenderv1.Feedback{
+			Id:          review.ID,
+			Description: review.Description,
+			CreatedAt:   parseTime(review.CreatedAt),
+			Ratings:     ratingsToProto(review.Ratings),
+			Replies:     make([]*tenderv1.FeedbackReply, len(review.Replies)),
+		}
+		for j := range review.Replies {
+			resp.Reviews[i].Replies[j] = feedbackReplyToProto(&review.Replies[j])
+		}
+	}
+	return resp
+}
+
+func feedbackReplyToProto(reply *models.FeedbackReplyResponse) *tenderv1.FeedbackReply {
+	return &tenderv1.FeedbackReply{
+		Id:         reply.ID,
+		FeedbackId: reply.FeedbackID,
+		Author:     reply.Author,
+		Text:       reply.Text,
+		CreatedAt:  parseTime(reply.CreatedAt),
+	}
+}
+
+func moderationItemToProto(item *models.ModerationItemResponse) *tenderv1.ModerationItem {
+	return &tenderv1.ModerationItem{
+		FeedbackId:      item.FeedbackID,
+		ReplyId:         item.ReplyID,
+		BidId:           item.BidID,
+		TenderId:        item.TenderID,
+		Text:            item.Text,
+		Reports:         int32(item.Reports),
+		Reasons:         item.Reasons,
+		FirstReportedAt: parseTime(item.FirstReportedAt),
+		Hidden:          item.Hidden,
+	}
+}
+
+func ratingsFromProto(ratings *tenderv1.FeedbackRatings) *models.FeedbackRatings {
+	if ratings == nil {
+		return nil
+	}
+	return &models.FeedbackRatings{
+		Quality:       int(ratings.GetQuality()),
+		Timeliness:    int(ratings.GetTimeliness()),
+		Communication: int(ratings.GetCommunication()),
+	}
+}
+
+func ratingsToProto(ratings *models.FeedbackRatings) *tenderv1.FeedbackRatings {
+	if ratings == nil {
+		return nil
+	}
+	return &tenderv1.FeedbackRatings{
+		Quality:       int32(ratings.Quality),
+		Timeliness:    int32(ratings.Timeliness),
+		Communication: int32(ratings.Communication),
+	}
+}
+
+func reputationToProto(reputation *models.ReputationResponse) *tenderv1.Reputation {
+	if reputation == nil {
+		return nil
+	}
+	resp := &tenderv1.Reputation{
+		AuthorType: authorTypesToProto[reputation.AuthorType],
+		AuthorId:   reputation.AuthorID,
+		Reviews:    int32(reputation.Reviews),
+		Tenders:    int32(reputation.Tenders),
+	}
+	if averages := reputation.Ratings; averages != nil {
+		resp.Ratings = &tenderv1.RatingAverages{
+			Quality:       averages.Quality,
+			Timeliness:    averages.Timeliness,
+			Communication: averages.Communication,
+			Overall:       averages.Overall,
+		}
+	}
+	return resp
+}
+
+func withdrawalsToProto(withdrawals []models.BidWithdrawalResponse) *tenderv1.ListBidWithdrawalsResponse {
+	resp := &tenderv1.ListBidWithdrawalsResponse{Withdrawals: make([]*tenderv1.BidWithdrawal, len(withdrawals))}
+	for i, withdrawal := range withdrawals {
+		resp.Withdrawals[i] = &tenderv1.BidWithdrawal{
+			Id:             withdrawal.ID,
+			BidId:          withdrawal.BidID,
+			Version:        int32(withdrawal.Version),
+			Reason:         withdrawal.Reason,
+			WithdrawnAt:    parseTime(withdrawal.WithdrawnAt),
+			ResubmitReason: withdrawal.ResubmitReason,
+			ResubmittedAt:  parseTime(withdrawal.ResubmittedAt),
+			DecisionsReset: withdrawal.DecisionsReset,
+		}
+	}
+	return resp
+}
+
+func decisionsToProto(decisions []models.BidDecisionResponse) *tenderv1.ListBidDecisionsResponse {
+	resp := &tenderv1.ListBidDecisionsResponse{Decisions: make([]*tenderv1.BidDecision, len(decisions))}
+	for i, decision := range decisions {
+		resp.Decisions[i] = &tenderv1.BidDecision{
+			Id:            decision.ID,
+			BidId:         decision.BidID,
+			LotId:         decision.LotID,
+			BidVersion:    int32(decision.BidVersion),
+			Author:        decision.Author,
+			Decision:      decisionTypesToProto[decision.Decision],
+			Comment:       decision.Comment,
+			CreatedAt:     parseTime(decision.CreatedAt),
+			RevokedAt:     parseTime(decision.RevokedAt),
+			InvalidatedAt: parseTime(decision.InvalidatedAt),
+		}
+	}
+	return resp
+}
+
+func statusChangeToProto(change service.StatusChange) *tenderv1.StatusChange {
+	resp := &tenderv1.StatusChange{ChangedAt: timestamppb.New(change.ChangedAt)}
+	switch change.Kind {
+	case service.KindTender:
+		resp.Entity = &tenderv1.StatusChange_Tender{Tender: &tenderv1.TenderStatusChange{
+			TenderId: change.ID,
+			Status:   tenderStatusesToProto[change.Status],
+			Version:  int32(change.Version),
+		}}
+	case service.KindBid:
+		resp.Entity = &tenderv1.StatusChange_Bid{Bid: &tenderv1.BidStatusChange{
+			BidId:        change.ID,
+			TenderId:     change.TenderID,
+			Status:       bidStatusesToProto[change.Status],
+			Coordination: coordinationsToProto[change.Coordination],
+			Version:      int32(change.Version),
+			Reason:       change.Reason,
+		}}
+	}
+	return resp
+}
+
+func awardToProto(award *models.AwardResponse) *tenderv1.Award {
+	resp := &tenderv1.Award{
+		Id:           award.ID,
+		TenderId:     award.TenderID,
+		LotId:        award.LotID,
+		BidId:        award.BidID,
+		BidVersion:   int32(award.BidVersion),
+		Price:        award.Price,
+		Status:       awardStatusesToProto[award.Status],
+		StatusReason: award.StatusReason,
+		CreatedAt:    parseTime(award.CreatedAt),
+		UpdatedAt:    parseTime(award.UpdatedAt),
+		Milestones:   make([]*tenderv1.AwardMilestone, len(award.Milestones)),
+	}
+	for i, milestone := range award.Milestones {
+		resp.Milestones[i] = &tenderv1.AwardMilestone{
+			Id:          milestone.ID,
+			Name:        milestone.Name,
+			Description: milestone.Description,
+			DueDate:     parseTime(milestone.DueDate),
+			CompletedAt: parseTime(milestone.CompletedAt),
+			Overdue:     milestone.Overdue,
+		}
+	}
+	return resp
+}
+
+func awardFeedbackFromProto(feedback *tenderv1.AwardFeedback) *models.FeedbackRequest {
+	if feedback == nil {
+		return nil
+	}
+	return &models.FeedbackRequest{
+		Description: feedback.GetDescription(),
+		Ratings:     ratingsFromProto(feedback.GetRatings()),
+	}
+}
+
+func invitationToProto(invitation *models.TenderInvitationResponse) *tenderv1.TenderInvitation {
+	return &tenderv1.TenderInvitation{
+		Id:             invitation.ID,
+		TenderId:       invitation.TenderID,
+		TenderName:     invitation.TenderName,
+		Username:       invitation.Username,
+		OrganizationId: invitation.OrganizationID,
+		Status:         invitationStatusesToProto[invitation.Status],
+		CreatedAt:      parseTime(invitation.CreatedAt),
+		RespondedAt:    parseTime(invitation.RespondedAt),
+	}
+}
+
+func invitationsToProto(invitations []models.TenderInvitationResponse) *tenderv1.ListTenderInvitationsResponse {
+	resp := &tenderv1.ListTenderInvitationsResponse{Invitations: make([]*tenderv1.TenderInvitation, len(invitations))}
+	for i := range invitations {
+		resp.Invitations[i] = invitationToProto(&invitations[i])
+	}
+	return resp
+}
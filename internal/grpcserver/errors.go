@@ -0,0 +1,45 @@
+package grpcserver
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tender-service/internal/service"
+)
+
+// toStatus переводит ошибку сервисного слоя в gRPC-статус; причина передаётся без изменений
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	var serr *service.Error
+	if !errors.As(err, &serr) {
+		return status.Error(codes.Internal, "Внутренняя ошибка сервера")
+	}
+	return status.Error(httpToCode(serr.Code), serr.Reason)
+}
+
+func httpToCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
@@ -0,0 +1,152 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"tender-service/internal/logger"
+	"tender-service/internal/metrics"
+	"tender-service/internal/tracing"
+)
+
+// requestIDKey — ключ метаданных с идентификатором запроса, аналог заголовка X-Request-ID
+const requestIDKey = "x-request-id"
+
+// startCall готовит контекст вызова так же, как HTTP-middleware: идентификатор запроса,
+// логгер и серверный спан, продолжающий трассу из метаданных traceparent
+func startCall(ctx context.Context, method string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := ""
+	if values := md.Get(requestIDKey); len(values) > 0 && len(values[0]) <= 100 {
+		requestID = values[0]
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	ctx = logger.WithRequestID(ctx, requestID)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, requestID))
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	ctx, span := tracing.Tracer().Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.RPCSystemGRPC, semconv.RPCMethod(method)),
+	)
+	if sc := span.SpanContext(); sc.IsValid() {
+		log := logger.FromContext(ctx).With("trace_id", sc.TraceID().String(), "span_id", sc.SpanID().String())
+		ctx = logger.WithLogger(ctx, log)
+	}
+	return ctx, span
+}
+
+// finishCall пишет access-лог, метрики и статус спана. Непредвиденная паника
+// превращается в codes.Internal, как ответ 500 в REST.
+func finishCall(ctx context.Context, span trace.Span, method string, start time.Time, streaming bool, rec interface{}, err *error) {
+	log := logger.FromContext(ctx)
+	if rec != nil {
+		log.Error("grpc handler panic", "panic", rec, "stack", string(debug.Stack()))
+		*err = status.Error(codes.Internal, "Внутренняя ошибка сервера")
+	}
+
+	code := status.Code(*err)
+	duration := time.Since(start)
+	if streaming {
+		metrics.GRPCRequest(method, code.String(), 0)
+	} else {
+		metrics.GRPCRequest(method, code.String(), duration)
+	}
+
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(code)))
+	if isServerError(code) {
+		span.SetStatus(otelcodes.Error, code.String())
+	}
+	span.End()
+
+	level := slog.LevelInfo
+	if isServerError(code) {
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", duration),
+		slog.String("user", logger.UserFromContext(ctx)),
+	}
+	if *err != nil {
+		attrs = append(attrs, slog.String("reason", status.Convert(*err).Message()))
+	}
+	log.LogAttrs(ctx, level, "grpc request", attrs...)
+}
+
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		return true
+	}
+	return false
+}
+
+func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	start := time.Now()
+	ctx, span := startCall(ctx, info.FullMethod)
+	defer func() {
+		finishCall(ctx, span, info.FullMethod, start, false, recover(), &err)
+	}()
+	return handler(ctx, req)
+}
+
+func streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+	ctx, span := startCall(ss.Context(), info.FullMethod)
+	defer func() {
+		finishCall(ctx, span, info.FullMethod, start, true, recover(), &err)
+	}()
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+// serverStream подменяет контекст потока контекстом с логгером и спаном
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// metadataCarrier позволяет пропагатору OpenTelemetry читать метаданные gRPC
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+var _ propagation.TextMapCarrier = metadataCarrier(nil)
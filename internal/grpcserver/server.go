@@ -0,0 +1,60 @@
+// Package grpcserver реализует gRPC-интерфейс из api/proto/tender/v1 поверх сервисного слоя
+package grpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	tenderv1 "tender-service/api/proto/tender/v1"
+	"tender-service/internal/health"
+	"tender-service/internal/service"
+)
+
+// Server — gRPC-сервер сервиса тендеров со стандартным сервисом здоровья grpc.health.v1
+type Server struct {
+	*grpc.Server
+	health *grpchealth.Server
+}
+
+// New регистрирует TenderService и BidService, сервис здоровья и, если reflectionEnabled,
+// сервис reflection для grpcurl и подобных инструментов
+func New(reflectionEnabled bool) *Server {
+	s := &Server{
+		Server: grpc.NewServer(
+			grpc.ChainUnaryInterceptor(unaryInterceptor),
+			grpc.ChainStreamInterceptor(streamInterceptor),
+		),
+		health: grpchealth.NewServer(),
+	}
+	tenderv1.RegisterTenderServiceServer(s.Server, &tenderServer{})
+	tenderv1.RegisterBidServiceServer(s.Server, &bidServer{})
+	healthpb.RegisterHealthServer(s.Server, s.health)
+	if reflectionEnabled {
+		reflection.Register(s.Server)
+	}
+	return s
+}
+
+// SyncHealth переносит результат проверки готовности /readyz в grpc.health.v1:
+// общий статус ("") и статусы обоих сервисов
+func (s *Server) SyncHealth(ctx context.Context) error {
+	status := healthpb.HealthCheckResponse_SERVING
+	if !health.Check(ctx).OK() {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, service := range []string{"", tenderv1.TenderService_ServiceDesc.ServiceName, tenderv1.BidService_ServiceDesc.ServiceName} {
+		s.health.SetServingStatus(service, status)
+	}
+	return nil
+}
+
+// Drain переводит все сервисы в NOT_SERVING, игнорируя дальнейшие обновления статуса,
+// и завершает потоки WatchStatusChanges, чтобы клиенты переподключились к другому экземпляру
+func (s *Server) Drain() {
+	s.health.Shutdown()
+	service.CloseSubscriptions()
+}
@@ -0,0 +1,305 @@
+package grpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenderv1 "tender-service/api/proto/tender/v1"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+)
+
+type tenderServer struct {
+	tenderv1.UnimplementedTenderServiceServer
+}
+
+func (tenderServer) CreateTender(ctx context.Context, req *tenderv1.CreateTenderRequest) (*tenderv1.Tender, error) {
+	tender, err := service.CreateTender(ctx, &models.TenderRequest{
+		Name:            req.GetName(),
+		Description:     req.GetDescription(),
+		ServiceType:     serviceTypes[req.GetServiceType()],
+		OrganizationID:  req.GetOrganizationId(),
+		CreatorUsername: req.GetCreatorUsername(),
+		Lots:            lotsFromProto(req.GetLots()),
+		BidDeadline:     timeFromProto(req.GetBidDeadline()),
+		Sealed:          req.GetSealed(),
+		Auction:         auctionFromProto(req.GetAuction()),
+		Visibility:      visibilities[req.GetVisibility()],
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tenderToProto(tender), nil
+}
+
+func (tenderServer) ListTenders(ctx context.Context, req *tenderv1.ListTendersRequest) (*tenderv1.ListTendersResponse, error) {
+	types := make([]string, len(req.GetServiceTypes()))
+	for i, serviceType := range req.GetServiceTypes() {
+		types[i] = string(serviceTypes[serviceType])
+	}
+	limit, offset := pageParams(req.GetPage())
+	tenders, err := service.ListTenders(ctx, types, limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tendersToProto(tenders), nil
+}
+
+func (tenderServer) ListUserTenders(ctx context.Context, req *tenderv1.ListUserTendersRequest) (*tenderv1.ListTendersResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	tenders, err := service.ListUserTenders(ctx, req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tendersToProto(tenders), nil
+}
+
+func (tenderServer) GetTenderStatus(ctx context.Context, req *tenderv1.GetTenderStatusRequest) (*tenderv1.GetTenderStatusResponse, error) {
+	tenderStatus, version, err := service.GetTenderStatus(ctx, req.GetTenderId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &tenderv1.GetTenderStatusResponse{Status: tenderStatusesToProto[tenderStatus], Version: int32(version)}, nil
+}
+
+func (tenderServer) UpdateTenderStatus(ctx context.Context, req *tenderv1.UpdateTenderStatusRequest) (*tenderv1.Tender, error) {
+	tender, err := service.UpdateTenderStatus(ctx, req.GetTenderId(), tenderStatuses[req.GetStatus()], req.GetUsername(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tenderToProto(tender), nil
+}
+
+func (tenderServer) EditTender(ctx context.Context, req *tenderv1.EditTenderRequest) (*tenderv1.Tender, error) {
+	tender, err := service.EditTender(ctx, req.GetTenderId(), req.GetUsername(), &models.TenderEditRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		ServiceType: serviceTypes[req.GetServiceType()],
+		BidDeadline: timeFromProto(req.GetBidDeadline()),
+	}, int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tenderToProto(tender), nil
+}
+
+func (tenderServer) RollbackTender(ctx context.Context, req *tenderv1.RollbackTenderRequest) (*tenderv1.Tender, error) {
+	tender, err := service.RollbackTender(ctx, req.GetTenderId(), int(req.GetVersion()), req.GetUsername(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return tenderToProto(tender), nil
+}
+
+func (tenderServer) ListTenderLots(ctx context.Context, req *tenderv1.ListTenderLotsRequest) (*tenderv1.ListTenderLotsResponse, error) {
+	lots, err := service.ListTenderLots(ctx, req.GetTenderId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return lotsToProto(lots), nil
+}
+
+func (tenderServer) AskClarification(ctx context.Context, req *tenderv1.AskClarificationRequest) (*tenderv1.Clarification, error) {
+	clarification, err := service.AskClarification(ctx, req.GetTenderId(), req.GetUsername(), &models.ClarificationRequest{
+		Question: req.GetQuestion(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return clarificationToProto(clarification), nil
+}
+
+func (tenderServer) ListClarifications(ctx context.Context, req *tenderv1.ListClarificationsRequest) (*tenderv1.ListClarificationsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	clarifications, err := service.ListClarifications(ctx, req.GetTenderId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return clarificationsToProto(clarifications), nil
+}
+
+func (tenderServer) AnswerClarification(ctx context.Context, req *tenderv1.AnswerClarificationRequest) (*tenderv1.Clarification, error) {
+	clarification, err := service.AnswerClarification(ctx, req.GetTenderId(), req.GetClarificationId(), req.GetUsername(), &models.ClarificationAnswerRequest{
+		Answer: req.GetAnswer(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return clarificationToProto(clarification), nil
+}
+
+func (tenderServer) SetEvaluationCriteria(ctx context.Context, req *tenderv1.SetEvaluationCriteriaRequest) (*tenderv1.ListEvaluationCriteriaResponse, error) {
+	criteria, err := service.SetEvaluationCriteria(ctx, req.GetTenderId(), req.GetUsername(), criteriaFromProto(req.GetCriteria()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return criteriaToProto(criteria), nil
+}
+
+func (tenderServer) ListEvaluationCriteria(ctx context.Context, req *tenderv1.ListEvaluationCriteriaRequest) (*tenderv1.ListEvaluationCriteriaResponse, error) {
+	criteria, err := service.ListEvaluationCriteria(ctx, req.GetTenderId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return criteriaToProto(criteria), nil
+}
+
+func (tenderServer) GetBidRanking(ctx context.Context, req *tenderv1.GetBidRankingRequest) (*tenderv1.GetBidRankingResponse, error) {
+	ranking, err := service.GetBidRanking(ctx, req.GetTenderId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return rankingToProto(ranking), nil
+}
+
+func (tenderServer) ListAuditEvents(ctx context.Context, req *tenderv1.ListAuditEventsRequest) (*tenderv1.ListAuditEventsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	events, err := service.ListAuditEvents(ctx, req.GetTenderId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return auditEventsToProto(events), nil
+}
+
+func (tenderServer) GetAuction(ctx context.Context, req *tenderv1.GetAuctionRequest) (*tenderv1.Auction, error) {
+	auction, err := service.GetAuction(ctx, req.GetTenderId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return auctionToProto(auction), nil
+}
+
+func (tenderServer) ListTenderAwards(ctx context.Context, req *tenderv1.ListTenderAwardsRequest) (*tenderv1.ListTenderAwardsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	awards, err := service.ListTenderAwards(ctx, req.GetTenderId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &tenderv1.ListTenderAwardsResponse{Awards: make([]*tenderv1.Award, len(awards))}
+	for i := range awards {
+		resp.Awards[i] = awardToProto(&awards[i])
+	}
+	return resp, nil
+}
+
+func (tenderServer) GetAward(ctx context.Context, req *tenderv1.GetAwardRequest) (*tenderv1.Award, error) {
+	award, err := service.GetAward(ctx, req.GetAwardId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return awardToProto(award), nil
+}
+
+func (tenderServer) UpdateAwardStatus(ctx context.Context, req *tenderv1.UpdateAwardStatusRequest) (*tenderv1.Award, error) {
+	award, err := service.UpdateAwardStatus(ctx, req.GetAwardId(), awardStatuses[req.GetStatus()], req.GetUsername(), &models.AwardStatusRequest{
+		Reason:   req.GetReason(),
+		Feedback: awardFeedbackFromProto(req.GetFeedback()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return awardToProto(award), nil
+}
+
+func (tenderServer) AddAwardMilestone(ctx context.Context, req *tenderv1.AddAwardMilestoneRequest) (*tenderv1.Award, error) {
+	award, err := service.AddAwardMilestone(ctx, req.GetAwardId(), req.GetUsername(), &models.AwardMilestoneRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		DueDate:     timeFromProto(req.GetDueDate()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return awardToProto(award), nil
+}
+
+func (tenderServer) CompleteAwardMilestone(ctx context.Context, req *tenderv1.CompleteAwardMilestoneRequest) (*tenderv1.Award, error) {
+	award, err := service.CompleteAwardMilestone(ctx, req.GetAwardId(), req.GetMilestoneId(), req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return awardToProto(award), nil
+}
+
+func (tenderServer) InviteToTender(ctx context.Context, req *tenderv1.InviteToTenderRequest) (*tenderv1.TenderInvitation, error) {
+	invitation, err := service.InviteToTender(ctx, req.GetTenderId(), req.GetUsername(), &models.TenderInvitationRequest{
+		Username:       req.GetInviteeUsername(),
+		OrganizationID: req.GetOrganizationId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return invitationToProto(invitation), nil
+}
+
+func (tenderServer) ListTenderInvitations(ctx context.Context, req *tenderv1.ListTenderInvitationsRequest) (*tenderv1.ListTenderInvitationsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	invitations, err := service.ListTenderInvitations(ctx, req.GetTenderId(), req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return invitationsToProto(invitations), nil
+}
+
+func (tenderServer) RevokeTenderInvitation(ctx context.Context, req *tenderv1.RevokeTenderInvitationRequest) (*tenderv1.RevokeTenderInvitationResponse, error) {
+	if err := service.RevokeTenderInvitation(ctx, req.GetTenderId(), req.GetInvitationId(), req.GetUsername()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &tenderv1.RevokeTenderInvitationResponse{}, nil
+}
+
+func (tenderServer) ListUserInvitations(ctx context.Context, req *tenderv1.ListUserInvitationsRequest) (*tenderv1.ListTenderInvitationsResponse, error) {
+	limit, offset := pageParams(req.GetPage())
+	invitations, err := service.ListUserInvitations(ctx, req.GetUsername(), limit, offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return invitationsToProto(invitations), nil
+}
+
+func (tenderServer) RespondToInvitation(ctx context.Context, req *tenderv1.RespondToInvitationRequest) (*tenderv1.TenderInvitation, error) {
+	invitation, err := service.RespondToInvitation(ctx, req.GetInvitationId(), req.GetUsername(), req.GetAccept())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return invitationToProto(invitation), nil
+}
+
+func (tenderServer) WatchStatusChanges(req *tenderv1.WatchStatusChangesRequest, stream grpc.ServerStreamingServer[tenderv1.StatusChange]) error {
+	ctx := stream.Context()
+
+	user, err := service.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	sub := service.Subscribe()
+	defer sub.Unsubscribe()
+
+	responsible := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-sub.C:
+			if !ok {
+				if sub.Lagged() {
+					return status.Error(codes.ResourceExhausted, "Клиент не успевает получать события, подпишитесь заново")
+				}
+				return nil
+			}
+			if req.GetTenderId() != "" && change.TenderID != req.GetTenderId() {
+				continue
+			}
+			if !service.CanWatch(ctx, user, change, responsible) {
+				continue
+			}
+			if err := stream.Send(statusChangeToProto(change)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// uploadField — имя поля multipart-формы с файлом
+const uploadField = "file"
+
+func UploadTenderAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+	upload := readUpload(w, r)
+
+	attachment, err := service.UploadTenderAttachment(ctx, tenderID, username, upload)
+	checkServiceError(w, err)
+
+	writeJSON(w, attachment)
+}
+
+func GetTenderAttachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	attachments, err := service.ListTenderAttachments(ctx, tenderID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, attachments)
+}
+
+func DownloadTenderAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+	username := r.URL.Query().Get("username")
+
+	attachment, content, err := service.OpenTenderAttachment(ctx, tenderID, attachmentID, username)
+	checkServiceError(w, err)
+	defer content.Close()
+
+	serveAttachment(w, r, attachment, content)
+}
+
+func DeleteTenderAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+	username := r.URL.Query().Get("username")
+
+	err := service.DeleteTenderAttachment(ctx, tenderID, attachmentID, username)
+	checkServiceError(w, err)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func UploadBidAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+	upload := readUpload(w, r)
+
+	attachment, err := service.UploadBidAttachment(ctx, bidID, username, upload)
+	checkServiceError(w, err)
+
+	writeJSON(w, attachment)
+}
+
+func GetBidAttachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+
+	attachments, err := service.ListBidAttachments(ctx, bidID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, attachments)
+}
+
+func DownloadBidAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+	username := r.URL.Query().Get("username")
+
+	attachment, content, err := service.OpenBidAttachment(ctx, bidID, attachmentID, username)
+	checkServiceError(w, err)
+	defer content.Close()
+
+	serveAttachment(w, r, attachment, content)
+}
+
+func DeleteBidAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+	username := r.URL.Query().Get("username")
+
+	err := service.DeleteBidAttachment(ctx, bidID, attachmentID, username)
+	checkServiceError(w, err)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readUpload находит в теле multipart/form-data поле с файлом. Содержимое не буферизуется:
+// сервисный слой читает его прямо из тела запроса, а поля формы после file не читаются.
+func readUpload(w http.ResponseWriter, r *http.Request) *models.AttachmentUpload {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Ожидается тело multipart/form-data с полем file")
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			respondWithPanicError(w, http.StatusBadRequest, "Не передан файл в поле file")
+		}
+		if err != nil {
+			respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+		}
+		if part.FormName() == uploadField {
+			return &models.AttachmentUpload{
+				Name:        part.FileName(),
+				ContentType: part.Header.Get("Content-Type"),
+				Content:     part,
+			}
+		}
+	}
+}
+
+// serveAttachment отдаёт файл с исходным именем. http.ServeContent поддерживает Range
+// и условные запросы; ETag файла — его контрольная сумма.
+func serveAttachment(w http.ResponseWriter, r *http.Request, attachment *models.Attachment, content io.ReadSeeker) {
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", strconv.Quote(attachment.Checksum))
+	http.ServeContent(w, r, "", attachment.CreatedAt, content)
+}
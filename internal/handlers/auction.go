@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func GetAuctionHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	auction, err := service.GetAuction(ctx, tenderID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, auction)
+}
+
+func SubmitAuctionPriceHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.AuctionPriceRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	position, err := service.SubmitAuctionPrice(ctx, bidID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, position)
+}
+
+func GetAuctionPositionHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+
+	position, err := service.GetAuctionPosition(ctx, bidID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, position)
+}
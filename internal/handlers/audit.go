@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func GetTenderAuditHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	events, err := service.ListAuditEvents(ctx, tenderID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, events)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func GetAwardHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	awardID := chi.URLParam(r, "awardId")
+	username := r.URL.Query().Get("username")
+
+	award, err := service.GetAward(ctx, awardID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, award)
+}
+
+func GetTenderAwardsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	awards, err := service.ListTenderAwards(ctx, tenderID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, awards)
+}
+
+func UpdateAwardStatusHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	awardID := chi.URLParam(r, "awardId")
+	status := r.URL.Query().Get("status")
+	username := r.URL.Query().Get("username")
+
+	request := &models.AwardStatusRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil && !errors.Is(err, io.EOF) {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	award, err := service.UpdateAwardStatus(ctx, awardID, models.AwardStatus(status), username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, award)
+}
+
+func AddAwardMilestoneHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	awardID := chi.URLParam(r, "awardId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.AwardMilestoneRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	award, err := service.AddAwardMilestone(ctx, awardID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, award)
+}
+
+func CompleteAwardMilestoneHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	awardID := chi.URLParam(r, "awardId")
+	milestoneID := chi.URLParam(r, "milestoneId")
+	username := r.URL.Query().Get("username")
+
+	award, err := service.CompleteAwardMilestone(ctx, awardID, milestoneID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, award)
+}
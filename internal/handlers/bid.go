@@ -2,374 +2,287 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
+	"io"
 	"net/http"
-	"tender-service/internal/database"
 	"tender-service/internal/models"
+	"tender-service/internal/service"
 )
 
 func CreateBidHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidRequest := &models.BidRequest{}
 	if err := json.NewDecoder(r.Body).Decode(bidRequest); err != nil {
 		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат данных")
 	}
 
-	validateAuthorType(w, bidRequest.AuthorType)
-	validateID(w, bidRequest.AuthorID, "ID автора")
-	validateID(w, bidRequest.TenderID, "ID тендера")
-	validateName(w, bidRequest.Name, "предложения")
-	validateDescription(w, bidRequest.Description, "предложения")
+	bid, err := service.CreateBid(ctx, bidRequest)
+	checkServiceError(w, err)
 
-	tender := getAndValidateTenderByID(w, bidRequest.TenderID)
-	user := getAndValidateUserByID(w, bidRequest.AuthorID)
-
-	if bidRequest.AuthorType == models.AuthorTypeOrganization {
-		if !database.CheckUserOrganization(user.ID) {
-			respondWithPanicError(w, http.StatusForbidden, "Пользователь не связан с организацией")
-		}
-	}
-
-	if tender.Status != models.Published {
-		respondWithPanicError(w, http.StatusForbidden, "Тендер ещё не опубликован или закрыт")
-	}
-
-	bid := createBid(bidRequest)
-
-	if err := database.SaveBid(bid); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при создании предложения")
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
 }
 
 func GetUserBidsHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	username := r.URL.Query().Get("username")
 	limitParam := r.URL.Query().Get("limit")
 	offsetParam := r.URL.Query().Get("offset")
 
-	user := getAndValidateUserByUsername(w, username)
-
 	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	bids, err := database.GetBidsByUserID(user.ID, limit, offset)
-	if err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении предложений")
-	}
+	bids, err := service.ListUserBids(ctx, username, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(bids)
+	writeJSON(w, bids)
 }
 
 func GetBidsForTenderHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderID := chi.URLParam(r, "tenderId")
 	username := r.URL.Query().Get("username")
-
-	validateID(w, tenderID, "ID тендера")
-	validateUsername(w, username)
-
 	limitParam := r.URL.Query().Get("limit")
 	offsetParam := r.URL.Query().Get("offset")
-	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	user := getAndValidateUserByUsername(w, username)
-	tender := getAndValidateTenderByID(w, tenderID)
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	if !database.CheckUserOrganizationResponsibility(user.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Недостаточно прав для выполнения действия")
-	}
-	bids, err := database.GetBidsByTenderID(tenderID, limit, offset)
-	if err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении предложений")
-		return
-	}
+	bids, err := service.ListTenderBids(ctx, tenderID, username, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(bids)
+	writeJSON(w, bids)
 }
 
 func SubmitBidDecisionHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidID := chi.URLParam(r, "bidId")
 	decision := models.Сoordination(r.URL.Query().Get("decision"))
 	username := r.URL.Query().Get("username")
+	lotID := r.URL.Query().Get("lotId")
+	comment := r.URL.Query().Get("comment")
 
-	validateID(w, bidID, "ID предложения")
-	validateDecision(w, decision)
-	validateUsername(w, username)
+	bid, err := service.SubmitBidDecision(ctx, bidID, decision, username, lotID, comment)
+	checkServiceError(w, err)
 
-	user := getAndValidateUserByUsername(w, username)
-	bid := getAndValidateBidByID(w, bidID)
-	tender := getAndValidateTenderByID(w, bid.TenderID)
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
+}
 
-	if !database.CheckUserOrganizationResponsibility(user.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь не имеет ответственности к организации стенда")
-	}
-	if bid.Status != models.Published {
-		respondWithPanicError(w, http.StatusForbidden, "Предложение не может быть обработано, так как его статус находится в состоянии 'Создание' или 'Закрыто'. Решение может быть отправлено только для предложений в статусе 'Публичное'.")
-	}
-	if tender.Status != models.Published {
-		respondWithPanicError(w, http.StatusForbidden, "Тендер не может быть обработан, так как он находится в статусе 'Создание' или 'Закрыт'. Для отправление решении тендер должен находиться в статусе 'Публичный'.")
-	}
+func RevokeBidDecisionHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	userDecision := &models.UserDecision{
-		UserID:   user.ID,
-		BidID:    bid.ID,
-		Decision: decision,
-	}
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+	lotID := r.URL.Query().Get("lotId")
 
-	if database.CheckUserDecisionExists(userDecision) {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь раннее давал свое решение")
-	}
+	bid, err := service.RevokeBidDecision(ctx, bidID, username, lotID)
+	checkServiceError(w, err)
 
-	if err := database.SaveUserDecision(userDecision); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при сохранении решения")
-	}
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
+}
 
-	userDecisions, _ := database.GetApprovedDecisionsByBidID(bid.ID)
-	
-	if decision == models.Rejected {
-		bid.Сoordination = models.Rejected
-		bid.Status = models.Closed
-	} else if len(userDecisions) >= 3 {
-		bid.Сoordination = models.Approved
-		bid.Status = models.Closed
-		bids, err := database.GetBidsByTenderIDWithExpectation(tender.ID)
-		if err != nil {
-			respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении предложений")
-		}
-		for _, Bid := range bids {
-			Bid.Status = models.Closed
-			Bid.Сoordination = models.RejectedByConflict
-			if err := database.UpdateBid(&Bid); err != nil {
-				respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении статуса предложения")
-			}
-		}
-	}
+func GetBidDecisionsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	if err := database.UpdateBid(bid); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении статуса предложения")
-	}
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	decisions, err := service.ListBidDecisions(ctx, bidID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, decisions)
 }
 
 func GetBidStatusHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidID := chi.URLParam(r, "bidId")
 	username := r.URL.Query().Get("username")
 
-	validateID(w, bidID, "ID предложения")
-	validateUsername(w, username)
-	bid := getAndValidateBidByID(w, bidID)
+	status, version, err := service.GetBidStatus(ctx, bidID, username)
+	checkServiceError(w, err)
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(bid.Status)
+	setETag(w, version)
+	writeJSON(w, status)
 }
 
 func UpdateBidStatusHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidID := chi.URLParam(r, "bidId")
 	newStatus := r.URL.Query().Get("status")
 	username := r.URL.Query().Get("username")
+	expectedVersion := parseIfMatch(w, r)
 
-	validateID(w, bidID, "ID предложения")
-	validateStatus(w, models.Status(newStatus))
-	validateUsername(w, username)
-
-	user := getAndValidateUserByUsername(w, username)
-	bid := getAndValidateBidByID(w, bidID)
-
-	if user.ID != bid.AuthorID {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь не имеет доступа к предложению")
-	}
-
-	bid.Status = models.Status(newStatus)
+	bid, err := service.UpdateBidStatus(ctx, bidID, models.Status(newStatus), username, expectedVersion)
+	checkServiceError(w, err)
 
-	if err := database.UpdateBid(bid); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении статуса тендера")
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
 }
 
 func EditBidHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidID := chi.URLParam(r, "bidId")
 	username := r.URL.Query().Get("username")
 
-	validateID(w, bidID, "ID предложения")
-	validateUsername(w, username)
-
-	bid := getAndValidateBidByID(w, bidID)
-	user := getAndValidateUserByUsername(w, username)
-
-	if user.ID != bid.AuthorID {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь не имеет доступа к предложению")
-	}
-
-	var editBidHandler models.BidEditRequest
-	if err := json.NewDecoder(r.Body).Decode(&editBidHandler); err != nil {
+	var bidEditRequest models.BidEditRequest
+	if err := json.NewDecoder(r.Body).Decode(&bidEditRequest); err != nil {
 		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
 	}
-	if editBidHandler.Name == "" && editBidHandler.Description == "" {
-		respondWithPanicError(w, http.StatusBadRequest, "Отправлен пустой запрос")
-	}
-	copybid := *bid
-	updateBidFields(w, &editBidHandler, bid)
-	saveBidHistory(w, &copybid)
-	bid.Version++
-	if err := database.UpdateBid(bid); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении тендера")
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	expectedVersion := parseIfMatch(w, r)
+
+	bid, err := service.EditBid(ctx, bidID, username, &bidEditRequest, expectedVersion)
+	checkServiceError(w, err)
+
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
 }
 
 func RollbackBidHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	bidID := chi.URLParam(r, "bidId")
-	versionParam := chi.URLParam(r, "version")
+	version := parseVersion(w, chi.URLParam(r, "version"))
 	username := r.URL.Query().Get("username")
+	expectedVersion := parseIfMatch(w, r)
 
-	validateID(w, bidID, "ID тендера")
-	validateUsername(w, username)
+	bid, err := service.RollbackBid(ctx, bidID, version, username, expectedVersion)
+	checkServiceError(w, err)
 
-	bid := getAndValidateBidByID(w, bidID)
-	user := getAndValidateUserByUsername(w, username)
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
+}
 
-	version := validateVersion(w, versionParam, bid.Version)
+func SubmitBidFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	if user.ID != bid.AuthorID {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.FeedbackRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil && !errors.Is(err, io.EOF) {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+	// Прежний формат: текст отзыва без оценок в параметре bidFeedback
+	if request.Description == "" {
+		request.Description = r.URL.Query().Get("bidFeedback")
 	}
 
-	bidHistory := getAndValidateBidHistoryVersion(w, bid.ID, version)
+	bid, err := service.SubmitBidFeedback(ctx, bidID, username, request)
+	checkServiceError(w, err)
 
-	saveBidHistory(w, bid)
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
+}
 
-	bid.Name = bidHistory.Name
-	bid.Description = bidHistory.Description
-	bid.Version++
+func GetBidReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	if err := database.UpdateBid(bid); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении тендера")
-	}
+	tenderID := chi.URLParam(r, "tenderId")
+	authorUsername := r.URL.Query().Get("authorUsername")
+	requesterUsername := r.URL.Query().Get("requesterUsername")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	reviews, err := service.ListBidReviews(ctx, tenderID, authorUsername, requesterUsername, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	writeJSON(w, reviews)
 }
 
-func SubmitBidFeedbackHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+func GetReputationHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	bidID := chi.URLParam(r, "bidId")
-	bidFeedback := r.URL.Query().Get("bidFeedback")
+	authorType := models.AuthorType(r.URL.Query().Get("authorType"))
+	authorID := r.URL.Query().Get("authorId")
 	username := r.URL.Query().Get("username")
 
-	validateID(w, bidID, "ID предложения")
-	validateUsername(w, username)
-	validateFeedback(w, bidFeedback)
+	reputation, err := service.GetReputation(ctx, authorType, authorID, username)
+	checkServiceError(w, err)
 
-	user := getAndValidateUserByUsername(w, username)
-	bid := getAndValidateBidByID(w, bidID)
-	teder := getAndValidateTenderByID(w, bid.TenderID)
+	writeJSON(w, reputation)
+}
 
-	if !database.CheckUserOrganizationResponsibility(user.ID, teder.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "пользователь не имеет ответственности к организации тендера")
-	}
+func WithdrawBidHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	if bid.Status == models.Closed {
-		respondWithPanicError(w, http.StatusForbidden, "Отзыв может быть отправлен только на опубликованное или закрытое предложение")
-	}
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
 
-	feedback := &models.Feedback{
-		UserID:      user.ID,
-		BidID:       bid.ID,
-		BidFeedback: bidFeedback,
+	request := &models.BidWithdrawalRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
 	}
+	expectedVersion := parseIfMatch(w, r)
 
-	if err := database.SaveFeedback(feedback); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при сохранении отзыва")
-	}
+	bid, err := service.WithdrawBid(ctx, bidID, username, request, expectedVersion)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createBidResponse(bid))
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
 }
 
-func GetBidReviewsHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+func ResubmitBidHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	tenderID := chi.URLParam(r, "tenderId")
-	authorUsername := r.URL.Query().Get("authorUsername")
-	requesterUsername := r.URL.Query().Get("requesterUsername")
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
 
-	validateID(w, tenderID, "ID тендера")
-	validateUsername(w, authorUsername)
-	validateUsername(w, requesterUsername)
+	// Тело необязательно: причину повторной подачи можно не указывать
+	request := &models.BidWithdrawalRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil && !errors.Is(err, io.EOF) {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+	expectedVersion := parseIfMatch(w, r)
 
-	authorUser := getAndValidateUserByUsername(w, authorUsername)
-	requesterUser := getAndValidateUserByUsername(w, requesterUsername)
+	bid, err := service.ResubmitBid(ctx, bidID, username, request, expectedVersion)
+	checkServiceError(w, err)
 
-	tender := getAndValidateTenderByID(w, tenderID)
-	bid := getAndValidateBidByTenderAndAuthorID(w, tender.ID, authorUser.ID)
+	setETag(w, bid.Version)
+	writeJSON(w, bid)
+}
 
-	if !database.CheckUserOrganizationResponsibility(requesterUser.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Недостаточно прав для просмотра отзывов на предложения")
-	}
+func GetBidWithdrawalsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
 	limitParam := r.URL.Query().Get("limit")
 	offsetParam := r.URL.Query().Get("offset")
 
 	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	feedbackResponse, err := database.GetBidReviews(bid.ID, limit, offset)
-	if err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении отзывов")
-	}
+	withdrawals, err := service.ListBidWithdrawals(ctx, bidID, username, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(feedbackResponse)
+	writeJSON(w, withdrawals)
 }
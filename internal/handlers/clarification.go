@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func AskClarificationHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.ClarificationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	clarification, err := service.AskClarification(ctx, tenderID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, clarification)
+}
+
+func GetClarificationsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	clarifications, err := service.ListClarifications(ctx, tenderID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, clarifications)
+}
+
+func AnswerClarificationHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	clarificationID := chi.URLParam(r, "clarificationId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.ClarificationAnswerRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	clarification, err := service.AnswerClarification(ctx, tenderID, clarificationID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, clarification)
+}
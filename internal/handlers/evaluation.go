@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func SetEvaluationCriteriaHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	var requests []models.CriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	criteria, err := service.SetEvaluationCriteria(ctx, tenderID, username, requests)
+	checkServiceError(w, err)
+
+	writeJSON(w, criteria)
+}
+
+func GetEvaluationCriteriaHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	criteria, err := service.ListEvaluationCriteria(ctx, tenderID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, criteria)
+}
+
+func SubmitBidScoresHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.BidScoresRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	evaluation, err := service.SubmitBidScores(ctx, bidID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, evaluation)
+}
+
+func GetBidRankingHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	ranking, err := service.GetBidRanking(ctx, tenderID, username)
+	checkServiceError(w, err)
+
+	writeJSON(w, ranking)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// callParseIfMatch вызывает parseIfMatch и перехватывает ответ с ошибкой, как это делает recoverPanic
+func callParseIfMatch(header string) (version int, rec *httptest.ResponseRecorder, rejected bool) {
+	rec = httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPatch, "/api/tenders/1/edit", nil)
+	if header != "" {
+		r.Header.Set("If-Match", header)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			if _, ok := p.(handlerError); !ok {
+				panic(p)
+			}
+			rejected = true
+		}
+	}()
+	return parseIfMatch(rec, r), rec, false
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    int
+		wantErr bool
+	}{
+		{name: "заголовка нет", header: "", want: 0},
+		{name: "любая версия", header: "*", want: 0},
+		{name: "версия в кавычках", header: `"3"`, want: 3},
+		{name: "слабый тег", header: `W/"12"`, want: 12},
+		{name: "пробелы вокруг значения", header: ` "5" `, want: 5},
+		{name: "версия без кавычек", header: "7", want: 7},
+		{name: "не число", header: `"abc"`, wantErr: true},
+		{name: "нулевая версия", header: `"0"`, wantErr: true},
+		{name: "отрицательная версия", header: `"-1"`, wantErr: true},
+		{name: "список тегов", header: `"1", "2"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rec, rejected := callParseIfMatch(tt.header)
+			if tt.wantErr {
+				if !rejected {
+					t.Fatalf("parseIfMatch(%q) = %d, want 400", tt.header, got)
+				}
+				if rec.Code != http.StatusBadRequest {
+					t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+				}
+				return
+			}
+			if rejected {
+				t.Fatalf("parseIfMatch(%q) rejected header: %s", tt.header, rec.Body.String())
+			}
+			if got != tt.want {
+				t.Errorf("parseIfMatch(%q) = %d, want %d", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetETagRoundTrip(t *testing.T) {
+	for _, version := range []int{1, 2, 42} {
+		rec := httptest.NewRecorder()
+		setETag(rec, version)
+		etag := rec.Header().Get("ETag")
+		if etag != `"`+strconv.Itoa(version)+`"` {
+			t.Errorf("setETag(%d) = %q", version, etag)
+		}
+		got, _, rejected := callParseIfMatch(etag)
+		if rejected || got != version {
+			t.Errorf("parseIfMatch(%q) = %d, rejected = %v, want %d", etag, got, rejected, version)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func InviteToTenderHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.TenderInvitationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	invitation, err := service.InviteToTender(ctx, tenderID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, invitation)
+}
+
+func GetTenderInvitationsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	invitations, err := service.ListTenderInvitations(ctx, tenderID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, invitations)
+}
+
+func RevokeTenderInvitationHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	tenderID := chi.URLParam(r, "tenderId")
+	invitationID := chi.URLParam(r, "invitationId")
+	username := r.URL.Query().Get("username")
+
+	err := service.RevokeTenderInvitation(ctx, tenderID, invitationID, username)
+	checkServiceError(w, err)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func GetUserInvitationsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	invitations, err := service.ListUserInvitations(ctx, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, invitations)
+}
+
+func AcceptInvitationHandler(w http.ResponseWriter, r *http.Request) {
+	respondToInvitation(w, r, true)
+}
+
+func DeclineInvitationHandler(w http.ResponseWriter, r *http.Request) {
+	respondToInvitation(w, r, false)
+}
+
+func respondToInvitation(w http.ResponseWriter, r *http.Request, accept bool) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	invitationID := chi.URLParam(r, "invitationId")
+	username := r.URL.Query().Get("username")
+
+	invitation, err := service.RespondToInvitation(ctx, invitationID, username, accept)
+	checkServiceError(w, err)
+
+	writeJSON(w, invitation)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"tender-service/internal/models"
+	"tender-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func ReplyToFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	feedbackID := chi.URLParam(r, "feedbackId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.FeedbackReplyRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	reply, err := service.ReplyToFeedback(ctx, feedbackID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, reply)
+}
+
+func ReportFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	feedbackID := chi.URLParam(r, "feedbackId")
+	replyID := r.URL.Query().Get("replyId")
+	username := r.URL.Query().Get("username")
+
+	request := &models.FeedbackReportRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+
+	report, err := service.ReportFeedback(ctx, feedbackID, replyID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, report)
+}
+
+func HideFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	feedbackID := chi.URLParam(r, "feedbackId")
+	replyID := r.URL.Query().Get("replyId")
+	username := r.URL.Query().Get("username")
+	request := decodeModerationRequest(w, r)
+
+	item, err := service.HideFeedback(ctx, feedbackID, replyID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, item)
+}
+
+func RestoreFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	feedbackID := chi.URLParam(r, "feedbackId")
+	replyID := r.URL.Query().Get("replyId")
+	username := r.URL.Query().Get("username")
+	request := decodeModerationRequest(w, r)
+
+	item, err := service.RestoreFeedback(ctx, feedbackID, replyID, username, request)
+	checkServiceError(w, err)
+
+	writeJSON(w, item)
+}
+
+func GetModerationQueueHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	items, err := service.ListModerationQueue(ctx, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, items)
+}
+
+func GetBidFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
+
+	bidID := chi.URLParam(r, "bidId")
+	username := r.URL.Query().Get("username")
+	limitParam := r.URL.Query().Get("limit")
+	offsetParam := r.URL.Query().Get("offset")
+
+	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
+
+	reviews, err := service.ListBidFeedback(ctx, bidID, username, limit, offset)
+	checkServiceError(w, err)
+
+	writeJSON(w, reviews)
+}
+
+// decodeModerationRequest читает необязательное тело с причиной решения модератора
+func decodeModerationRequest(w http.ResponseWriter, r *http.Request) *models.ModerationRequest {
+	request := &models.ModerationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil && !errors.Is(err, io.EOF) {
+		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
+	}
+	return request
+}
@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"github.com/go-chi/chi/v5"
 	"net/http"
-	"tender-service/internal/database"
 	"tender-service/internal/models"
+	"tender-service/internal/service"
 )
 
 func PingHandler(w http.ResponseWriter, r *http.Request) {
@@ -14,217 +14,128 @@ func PingHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func CreateTenderHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderRequest := &models.TenderRequest{}
 	if err := json.NewDecoder(r.Body).Decode(tenderRequest); err != nil {
 		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
 	}
 
-	validateName(w, tenderRequest.Name, "тендера")
-	validateDescription(w, tenderRequest.Description, "тендера")
-	validateServiceType(w, models.ServiceType(tenderRequest.ServiceType))
-	validateID(w, tenderRequest.OrganizationID, "ID организации")
-	validateUsername(w, tenderRequest.CreatorUsername)
+	tender, err := service.CreateTender(ctx, tenderRequest)
+	checkServiceError(w, err)
 
-	user := getAndValidateUserByUsername(w, tenderRequest.CreatorUsername)
-
-	if !database.CheckUserOrganizationResponsibility(user.ID, tenderRequest.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Пользователь не имеет прав для создания тендеров от имени этой организации")
-	}
-
-	tender := createTender(tenderRequest)
-
-	if err := database.SaveTender(tender); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при сохранении тендера")
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createTenderResponse(tender))
+	setETag(w, tender.Version)
+	writeJSON(w, tender)
 }
 
 func GetTendersHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	limitParam := r.URL.Query().Get("limit")
 	offsetParam := r.URL.Query().Get("offset")
 	serviceTypes := r.URL.Query()["service_type"]
 
-	for _, serviceType := range serviceTypes {
-		validateServiceType(w, models.ServiceType(serviceType))
-	}
-
 	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	tenders, err := database.GetTendersResponse(serviceTypes, limit, offset)
-	if err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении тендеров")
-	}
+	tenders, err := service.ListTenders(ctx, serviceTypes, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(tenders)
+	writeJSON(w, tenders)
 }
 
 func GetUserTendersHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	username := r.URL.Query().Get("username")
-	user := getAndValidateUserByUsername(w, username)
-
 	limitParam := r.URL.Query().Get("limit")
 	offsetParam := r.URL.Query().Get("offset")
 
 	limit, offset := validateLimitAndOffset(w, limitParam, offsetParam)
 
-	tenders, err := database.GetTendersByUsername(user.ID, limit, offset)
-	if err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при получении тендеров пользователя")
-	}
+	tenders, err := service.ListUserTenders(ctx, username, limit, offset)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(tenders)
+	writeJSON(w, tenders)
 }
 
 func GetTenderStatusHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderID := chi.URLParam(r, "tenderId")
 	username := r.URL.Query().Get("username")
 
-	validateID(w, tenderID, "ID тендера")
-	if username != "" {
-		getAndValidateUserByUsername(w, username)
-	}
-
-	tender := getAndValidateTenderByID(w, tenderID)
+	status, version, err := service.GetTenderStatus(ctx, tenderID, username)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(tender.Status)
+	setETag(w, version)
+	writeJSON(w, status)
 }
 
 func UpdateTenderStatusHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderID := chi.URLParam(r, "tenderId")
 	newStatus := r.URL.Query().Get("status")
 	username := r.URL.Query().Get("username")
+	expectedVersion := parseIfMatch(w, r)
 
-	validateID(w, tenderID, "ID тендера")
-	validateStatus(w, models.Status(newStatus))
-	validateUsername(w, username)
-
-	user := getAndValidateUserByUsername(w, username)
-
-	tender := getAndValidateTenderByID(w, tenderID)
-
-	if !database.CheckUserOrganizationResponsibility(user.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Пользователь не имеет отвественности за организацию текущего тендера для изменения статуса")
-	}
-
-	tender.Status = models.Status(newStatus)
-
-	if err := database.UpdateTender(tender); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении статуса тендера")
-	}
+	tender, err := service.UpdateTenderStatus(ctx, tenderID, models.Status(newStatus), username, expectedVersion)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createTenderResponse(tender))
+	setETag(w, tender.Version)
+	writeJSON(w, tender)
 }
 
 func EditTenderHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderID := chi.URLParam(r, "tenderId")
 	username := r.URL.Query().Get("username")
 
-	validateID(w, tenderID, "ID тендера")
-	validateUsername(w, username)
-
-	tender := getAndValidateTenderByID(w, tenderID)
-	user := getAndValidateUserByUsername(w, username)
-
-	if !database.CheckUserOrganizationResponsibility(user.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Недостаточно прав для редактирования тендера")
-	}
-
 	tenderEditRequest := &models.TenderEditRequest{}
-	err := json.NewDecoder(r.Body).Decode(tenderEditRequest)
-	if err != nil {
+	if err := json.NewDecoder(r.Body).Decode(tenderEditRequest); err != nil {
 		respondWithPanicError(w, http.StatusBadRequest, "Неверный формат запроса")
 	}
+	expectedVersion := parseIfMatch(w, r)
 
-	if tenderEditRequest.Name == "" && tenderEditRequest.Description == "" && tenderEditRequest.ServiceType == "" {
-		respondWithPanicError(w, http.StatusBadRequest, "Отправлен пустой запрос")
-	}
-
-	copyTender := *tender
-
-	updateTenderFields(w, tenderEditRequest, tender)
-	saveTenderHistory(w, &copyTender)
-
-	tender.Version++
-
-	if err := database.UpdateTender(tender); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении тендера")
-	}
+	tender, err := service.EditTender(ctx, tenderID, username, tenderEditRequest, expectedVersion)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createTenderResponse(tender))
+	setETag(w, tender.Version)
+	writeJSON(w, tender)
 }
 
 func RollbackTenderHandler(w http.ResponseWriter, r *http.Request) {
-	defer func() {
-		recover()
-	}()
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
 	tenderID := chi.URLParam(r, "tenderId")
-	versionParam := chi.URLParam(r, "version")
+	version := parseVersion(w, chi.URLParam(r, "version"))
 	username := r.URL.Query().Get("username")
+	expectedVersion := parseIfMatch(w, r)
 
-	validateID(w, tenderID, "ID тендера")
-	validateUsername(w, username)
-
-	tender := getAndValidateTenderByID(w, tenderID)
-	user := getAndValidateUserByUsername(w, username)
-
-	version := validateVersion(w, versionParam, tender.Version)
+	tender, err := service.RollbackTender(ctx, tenderID, version, username, expectedVersion)
+	checkServiceError(w, err)
 
-	if !database.CheckUserOrganizationResponsibility(user.ID, tender.OrganizationID) {
-		respondWithPanicError(w, http.StatusForbidden, "Недостаточно прав для выполнения действия")
-	}
-
-	tenderHistoryVersion := getAndValidateTenderHistoryVersion(w, tender.ID, version)
+	setETag(w, tender.Version)
+	writeJSON(w, tender)
+}
 
-	saveTenderHistory(w, tender)
+func GetTenderLotsHandler(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+	ctx := r.Context()
 
-	tender.Name = tenderHistoryVersion.Name
-	tender.Description = tenderHistoryVersion.Description
-	tender.ServiceType = tenderHistoryVersion.ServiceType
-	tender.Version++
+	tenderID := chi.URLParam(r, "tenderId")
+	username := r.URL.Query().Get("username")
 
-	if err := database.UpdateTender(tender); err != nil {
-		respondWithPanicError(w, http.StatusInternalServerError, "Ошибка при обновлении тендера")
-	}
+	lots, err := service.ListTenderLots(ctx, tenderID, username)
+	checkServiceError(w, err)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(createTenderResponse(tender))
-}
\ No newline at end of file
+	writeJSON(w, lots)
+}
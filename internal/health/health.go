@@ -0,0 +1,141 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const checkTimeout = 2 * time.Second
+
+// CheckFunc проверяет одну зависимость сервиса; nil означает, что компонент исправен
+type CheckFunc func(ctx context.Context) error
+
+var (
+	mu       sync.RWMutex
+	checks   = map[string]CheckFunc{}
+	draining atomic.Bool
+)
+
+// Register добавляет компонент в проверку готовности
+func Register(name string, check CheckFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	checks[name] = check
+}
+
+// SetDraining переводит сервис в режим остановки: /readyz начинает отвечать 503,
+// чтобы балансировщик перестал направлять новые запросы
+func SetDraining() {
+	draining.Store(true)
+}
+
+func IsDraining() bool {
+	return draining.Load()
+}
+
+type ComponentStatus struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+}
+
+// OK сообщает, что все компоненты исправны и сервис не останавливается
+func (r Report) OK() bool {
+	return r.Status == statusOK
+}
+
+const (
+	statusOK       = "ok"
+	statusFail     = "fail"
+	statusDraining = "draining"
+)
+
+// LivenessHandler отвечает 200, пока процесс способен обрабатывать запросы
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: statusOK})
+}
+
+// ReadinessHandler проверяет все зарегистрированные компоненты параллельно
+// и отдаёт 503, если хотя бы один из них неисправен или сервис останавливается
+func ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := Check(r.Context())
+	code := http.StatusOK
+	if !report.OK() {
+		code = http.StatusServiceUnavailable
+	}
+	writeReport(w, code, report)
+}
+
+// Check выполняет все проверки и собирает отчёт по компонентам
+func Check(ctx context.Context) Report {
+	mu.RLock()
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	mu.RUnlock()
+	sort.Strings(names)
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	results := make([]ComponentStatus, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		mu.RLock()
+		check := checks[name]
+		mu.RUnlock()
+
+		wg.Add(1)
+		go func(i int, check CheckFunc) {
+			defer wg.Done()
+			results[i] = runCheck(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{Status: statusOK, Components: make(map[string]ComponentStatus, len(names))}
+	for i, name := range names {
+		report.Components[name] = results[i]
+		if results[i].Status != statusOK {
+			report.Status = statusFail
+		}
+	}
+	if IsDraining() {
+		report.Status = statusDraining
+	}
+	return report
+}
+
+func runCheck(ctx context.Context, check CheckFunc) (status ComponentStatus) {
+	start := time.Now()
+	defer func() {
+		if rec := recover(); rec != nil {
+			status = ComponentStatus{Status: statusFail, Error: fmt.Sprint(rec)}
+		}
+		status.Duration = time.Since(start).String()
+	}()
+
+	if err := check(ctx); err != nil {
+		return ComponentStatus{Status: statusFail, Error: err.Error()}
+	}
+	return ComponentStatus{Status: statusOK}
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
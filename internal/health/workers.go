@@ -0,0 +1,90 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxWorkerFailures — сколько запусков подряд должно завершиться ошибкой, чтобы задача считалась
+// неисправной: одна временная ошибка не должна выводить экземпляр из ротации
+const maxWorkerFailures = 3
+
+// Worker описывает фоновую задачу, которая периодически отчитывается о своей работе.
+// Если задача не отчитывалась дольше maxSilence или maxWorkerFailures запусков подряд завершились
+// ошибкой, компонент workers в /readyz считается неисправным. Текст ошибок в /readyz не попадает:
+// его пишет в лог сама задача.
+type Worker struct {
+	name       string
+	maxSilence time.Duration
+
+	mu       sync.Mutex
+	lastBeat time.Time
+	failures int
+}
+
+var (
+	workersMu sync.Mutex
+	workers   = map[string]*Worker{}
+)
+
+func init() {
+	Register("workers", checkWorkers)
+}
+
+// RegisterWorker регистрирует фоновую задачу для проверки готовности
+func RegisterWorker(name string, maxSilence time.Duration) *Worker {
+	worker := &Worker{name: name, maxSilence: maxSilence, lastBeat: time.Now()}
+	workersMu.Lock()
+	workers[name] = worker
+	workersMu.Unlock()
+	return worker
+}
+
+// Beat сообщает об очередном запуске задачи и его результате
+func (w *Worker) Beat(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastBeat = time.Now()
+	if err != nil {
+		w.failures++
+	} else {
+		w.failures = 0
+	}
+}
+
+func (w *Worker) check() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if silence := time.Since(w.lastBeat); silence > w.maxSilence {
+		return fmt.Errorf("%s: нет отчёта %s", w.name, silence.Round(time.Second))
+	}
+	if w.failures >= maxWorkerFailures {
+		return fmt.Errorf("%s: последние %d запусков завершились ошибкой", w.name, w.failures)
+	}
+	return nil
+}
+
+func checkWorkers(ctx context.Context) error {
+	workersMu.Lock()
+	list := make([]*Worker, 0, len(workers))
+	for _, worker := range workers {
+		list = append(list, worker)
+	}
+	workersMu.Unlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+
+	var failures []string
+	for _, worker := range list {
+		if err := worker.check(); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Package idempotency делает безопасными повторы запросов с заголовком Idempotency-Key.
+// Первый ответ сохраняется по ключу пользователя и ключу запроса, повтор с теми же параметрами
+// получает сохранённый ответ, а повторное использование ключа с другими параметрами отклоняется.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+)
+
+const (
+	KeyHeader      = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	maxBodySize  = 1 << 20
+)
+
+type Store struct {
+	ttl   time.Duration
+	lease time.Duration
+}
+
+// New создаёт хранилище ключей; ключ действует ttl с момента первого запроса.
+// Ключ, занятый запросом, который не сохранил ответ за lease (процесс упал или был остановлен),
+// может занять повтор.
+func New(ttl, lease time.Duration) *Store {
+	return &Store{ttl: ttl, lease: lease}
+}
+
+// Middleware применяется к маршрутам, повтор которых нельзя выполнять дважды.
+// Запросы без заголовка Idempotency-Key проходят без изменений.
+func (s *Store) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(KeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		log := logger.FromContext(ctx)
+
+		if len(key) > maxKeyLength {
+			writeError(w, http.StatusBadRequest, "Ключ идемпотентности слишком длинный, максимум 255 символов")
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Неверный формат запроса")
+			return
+		}
+		if len(body) > maxBodySize {
+			// Обрезанное тело нельзя ни передать обработчику, ни сравнивать по хешу с повтором
+			writeError(w, http.StatusRequestEntityTooLarge, "Тело запроса с ключом идемпотентности слишком большое, максимум 1 МБ")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		userID := requestUser(ctx, r, body)
+		if userID == "" {
+			// Пользователь не найден: обработчик сам отклонит запрос, сохранять нечего
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		record := &models.IdempotencyKey{UserKey: userID, Key: key, RequestHash: requestHash(r, body)}
+		reserved, err := database.ReserveIdempotencyKey(ctx, record, s.ttl, s.lease)
+		if err != nil {
+			log.Error("failed to reserve idempotency key", "error", err)
+			writeError(w, http.StatusInternalServerError, "Ошибка при проверке ключа идемпотентности")
+			return
+		}
+		if !reserved {
+			s.replay(w, r, record)
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w}
+		completed := false
+		defer func() {
+			// Ответ с ошибкой сервера не сохраняется: повтор должен выполнить операцию заново
+			storeCtx := context.WithoutCancel(ctx)
+			if !completed {
+				if err := database.DeleteIdempotencyKey(storeCtx, record); err != nil {
+					log.Error("failed to release idempotency key", "error", err)
+				}
+			}
+		}()
+
+		next.ServeHTTP(rec, r)
+
+		if rec.status() >= http.StatusInternalServerError {
+			return
+		}
+		record.StatusCode = rec.status()
+		record.ContentType = rec.Header().Get("Content-Type")
+		record.ResponseBody = rec.body.Bytes()
+		if err := database.CompleteIdempotencyKey(context.WithoutCancel(ctx), record); err != nil {
+			log.Error("failed to store idempotent response", "error", err)
+			return
+		}
+		completed = true
+	})
+}
+
+// replay отвечает на повтор запроса с уже занятым ключом
+func (s *Store) replay(w http.ResponseWriter, r *http.Request, record *models.IdempotencyKey) {
+	stored, err := database.GetIdempotencyKey(r.Context(), record.UserKey, record.Key)
+	if err == pgx.ErrNoRows {
+		// Первый запрос только что завершился ошибкой сервера и освободил ключ
+		stored, err = nil, nil
+	}
+	if err != nil {
+		logger.FromContext(r.Context()).Error("failed to load idempotency key", "error", err)
+		writeError(w, http.StatusInternalServerError, "Ошибка при проверке ключа идемпотентности")
+		return
+	}
+	writeReplay(w, r, record, stored)
+}
+
+// writeReplay отвечает на повтор по сохранённой записи ключа; stored == nil — ключ уже освобождён
+func writeReplay(w http.ResponseWriter, r *http.Request, record, stored *models.IdempotencyKey) {
+	if stored == nil {
+		writeError(w, http.StatusConflict, "Запрос с этим ключом идемпотентности ещё выполняется, повторите позже")
+		return
+	}
+	if stored.RequestHash != record.RequestHash {
+		writeError(w, http.StatusUnprocessableEntity, "Ключ идемпотентности уже использован с другими параметрами запроса")
+		return
+	}
+	if stored.StatusCode == 0 {
+		writeError(w, http.StatusConflict, "Запрос с этим ключом идемпотентности ещё выполняется, повторите позже")
+		return
+	}
+
+	logger.FromContext(r.Context()).Info("idempotent request replayed", "idempotency_key", record.Key)
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	w.Header().Set(ReplayedHeader, "true")
+	w.WriteHeader(stored.StatusCode)
+	w.Write(stored.ResponseBody)
+}
+
+// Cleanup удаляет просроченные ключи; запускается фоновой задачей
+func (s *Store) Cleanup(ctx context.Context) error {
+	deleted, err := database.DeleteExpiredIdempotencyKeys(ctx, s.ttl)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.FromContext(ctx).Info("expired idempotency keys deleted", "count", deleted)
+	}
+	return nil
+}
+
+// requestUser находит пользователя так же, как обработчики: по параметру username
+// или по полям creatorUsername и authorId тела запроса. Возвращает ID пользователя.
+func requestUser(ctx context.Context, r *http.Request, body []byte) string {
+	var fields struct {
+		CreatorUsername string `json:"creatorUsername"`
+		AuthorID        string `json:"authorId"`
+	}
+	json.Unmarshal(body, &fields)
+
+	username := r.URL.Query().Get("username")
+	if username == "" {
+		username = fields.CreatorUsername
+	}
+	if username != "" {
+		if user, err := database.GetUserByUsername(ctx, username); err == nil {
+			return user.ID
+		}
+		return ""
+	}
+	if fields.AuthorID != "" {
+		if user, err := database.GetUserByID(ctx, fields.AuthorID); err == nil {
+			return user.ID
+		}
+	}
+	return ""
+}
+
+// requestHash отличает повтор того же запроса от другого запроса с тем же ключом
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.Path+"?"+r.URL.Query().Encode()+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(models.ErrorResponse{Reason: message})
+}
+
+// recorder передаёт ответ клиенту и одновременно запоминает его для сохранения
+type recorder struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
+
+func (r *recorder) status() int {
+	if r.code == 0 {
+		return http.StatusOK
+	}
+	return r.code
+}
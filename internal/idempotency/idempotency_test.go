@@ -0,0 +1,199 @@
+package idempotency
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"tender-service/internal/models"
+)
+
+func TestRequestHash(t *testing.T) {
+	base := requestHash(httptest.NewRequest(http.MethodPost, "/api/bids/new?username=user1", nil), []byte(`{"name":"a"}`))
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		same   bool
+	}{
+		{name: "тот же запрос", method: http.MethodPost, target: "/api/bids/new?username=user1", body: `{"name":"a"}`, same: true},
+		{name: "другое тело", method: http.MethodPost, target: "/api/bids/new?username=user1", body: `{"name":"b"}`},
+		{name: "другой метод", method: http.MethodPut, target: "/api/bids/new?username=user1", body: `{"name":"a"}`},
+		{name: "другой путь", method: http.MethodPost, target: "/api/tenders/new?username=user1", body: `{"name":"a"}`},
+		{name: "другой параметр", method: http.MethodPost, target: "/api/bids/new?username=user2", body: `{"name":"a"}`},
+		{name: "тело перенесено в путь", method: http.MethodPost, target: "/api/bids/new?username=user1%0A%7B%22name%22:%22a%22%7D", body: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := requestHash(httptest.NewRequest(tt.method, tt.target, nil), []byte(tt.body))
+			if (got == base) != tt.same {
+				t.Errorf("requestHash() equal = %v, want %v", got == base, tt.same)
+			}
+		})
+	}
+
+	// Порядок параметров запроса не должен менять хеш
+	a := requestHash(httptest.NewRequest(http.MethodPost, "/api/bids/1/submit_decision?decision=Approved&username=u", nil), nil)
+	b := requestHash(httptest.NewRequest(http.MethodPost, "/api/bids/1/submit_decision?username=u&decision=Approved", nil), nil)
+	if a != b {
+		t.Error("requestHash() depends on query parameter order")
+	}
+}
+
+func TestWriteReplay(t *testing.T) {
+	record := &models.IdempotencyKey{UserKey: "user", Key: "key", RequestHash: "hash"}
+
+	tests := []struct {
+		name         string
+		stored       *models.IdempotencyKey
+		wantCode     int
+		wantReplayed bool
+		wantBody     string
+	}{
+		{
+			name:     "ключ освобождён после ошибки первого запроса",
+			stored:   nil,
+			wantCode: http.StatusConflict,
+			wantBody: "ещё выполняется",
+		},
+		{
+			name:     "первый запрос ещё выполняется",
+			stored:   &models.IdempotencyKey{RequestHash: "hash"},
+			wantCode: http.StatusConflict,
+			wantBody: "ещё выполняется",
+		},
+		{
+			name:     "ключ использован с другими параметрами",
+			stored:   &models.IdempotencyKey{RequestHash: "other", StatusCode: http.StatusOK},
+			wantCode: http.StatusUnprocessableEntity,
+			wantBody: "другими параметрами",
+		},
+		{
+			name:     "другие параметры важнее незавершённого запроса",
+			stored:   &models.IdempotencyKey{RequestHash: "other"},
+			wantCode: http.StatusUnprocessableEntity,
+			wantBody: "другими параметрами",
+		},
+		{
+			name: "повтор получает сохранённый ответ",
+			stored: &models.IdempotencyKey{
+				RequestHash:  "hash",
+				StatusCode:   http.StatusOK,
+				ContentType:  "application/json",
+				ResponseBody: []byte(`{"id":"1"}`),
+			},
+			wantCode:     http.StatusOK,
+			wantReplayed: true,
+			wantBody:     `{"id":"1"}`,
+		},
+		{
+			name: "сохранённая ошибка клиента тоже повторяется",
+			stored: &models.IdempotencyKey{
+				RequestHash:  "hash",
+				StatusCode:   http.StatusBadRequest,
+				ContentType:  "application/json",
+				ResponseBody: []byte(`{"reason":"Неверный формат запроса"}`),
+			},
+			wantCode:     http.StatusBadRequest,
+			wantReplayed: true,
+			wantBody:     "Неверный формат запроса",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			writeReplay(w, httptest.NewRequest(http.MethodPost, "/api/bids/new", nil), record, tt.stored)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if replayed := w.Header().Get(ReplayedHeader) == "true"; replayed != tt.wantReplayed {
+				t.Errorf("%s header present = %v, want %v", ReplayedHeader, replayed, tt.wantReplayed)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("body = %q, want it to contain %q", w.Body.String(), tt.wantBody)
+			}
+			if w.Header().Get("Content-Type") != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+// Проверки до обращения к базе: ключ и тело запроса
+func TestMiddlewareRejectsBeforeReservation(t *testing.T) {
+	store := New(time.Hour, time.Minute)
+
+	tests := []struct {
+		name       string
+		key        string
+		body       string
+		wantCode   int
+		wantCalled bool
+	}{
+		{name: "без ключа", key: "", body: `{}`, wantCode: http.StatusOK, wantCalled: true},
+		{name: "слишком длинный ключ", key: strings.Repeat("k", maxKeyLength+1), body: `{}`, wantCode: http.StatusBadRequest},
+		{name: "слишком большое тело", key: "key", body: strings.Repeat("a", maxBodySize+1), wantCode: http.StatusRequestEntityTooLarge},
+		{name: "пользователь не указан", key: "key", body: `{"name":"a"}`, wantCode: http.StatusOK, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.body {
+					t.Errorf("handler got body %q, want %q", body, tt.body)
+				}
+			}))
+
+			r := httptest.NewRequest(http.MethodPost, "/api/bids/new", strings.NewReader(tt.body))
+			if tt.key != "" {
+				r.Header.Set(KeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}
+
+func TestRecorderStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(*recorder)
+		want  int
+	}{
+		{name: "ничего не записано", write: func(*recorder) {}, want: http.StatusOK},
+		{name: "только тело", write: func(r *recorder) { r.Write([]byte("ok")) }, want: http.StatusOK},
+		{name: "явный код", write: func(r *recorder) { r.WriteHeader(http.StatusCreated) }, want: http.StatusCreated},
+		{name: "первый код побеждает", write: func(r *recorder) {
+			r.WriteHeader(http.StatusBadRequest)
+			r.WriteHeader(http.StatusOK)
+		}, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{ResponseWriter: httptest.NewRecorder()}
+			tt.write(rec)
+			if got := rec.status(); got != tt.want {
+				t.Errorf("status() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
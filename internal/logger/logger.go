@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type ctxKey int
+
+const (
+	loggerKey ctxKey = iota
+	requestInfoKey
+)
+
+// Setup настраивает глобальный логгер: уровень (debug, info, warn, error) и формат вывода (json, text)
+func Setup(level, format string) error {
+	var lvl slog.Level
+	if level != "" {
+		if err := lvl.UnmarshalText([]byte(level)); err != nil {
+			return fmt.Errorf("некорректный уровень логирования %q: %w", level, err)
+		}
+	}
+
+	handler, err := newHandler(os.Stdout, format, lvl)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+func newHandler(out io.Writer, format string, level slog.Level) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	switch strings.ToLower(format) {
+	case "", "json":
+		return slog.NewJSONHandler(out, opts), nil
+	case "text":
+		return slog.NewTextHandler(out, opts), nil
+	default:
+		return nil, fmt.Errorf("некорректный формат логов %q, допустимые значения: json, text", format)
+	}
+}
+
+// FromContext возвращает логгер запроса или глобальный логгер, если запрос не найден
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+			return l
+		}
+	}
+	return slog.Default()
+}
+
+// WithLogger сохраняет логгер в контексте
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, l)
+}
@@ -0,0 +1,99 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// requestInfo заполняется по ходу обработки запроса и попадает в access-лог
+type requestInfo struct {
+	id   string
+	user string
+}
+
+// RequestID берёт идентификатор запроса из заголовка X-Request-ID или генерирует новый
+// и кладёт в контекст логгер, помеченный этим идентификатором
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" || len(requestID) > 100 {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), requestID)))
+	})
+}
+
+// WithRequestID кладёт в контекст идентификатор запроса и помеченный им логгер.
+// Используется транспортами, которые не проходят через RequestID, например gRPC.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestInfoKey, &requestInfo{id: requestID})
+	return WithLogger(ctx, slog.Default().With(slog.String("request_id", requestID)))
+}
+
+// RequestIDFromContext возвращает идентификатор текущего запроса
+func RequestIDFromContext(ctx context.Context) string {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		return info.id
+	}
+	return ""
+}
+
+// SetUser запоминает имя пользователя, от которого выполняется запрос, для access-лога
+func SetUser(ctx context.Context, username string) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.user = username
+	}
+}
+
+// UserFromContext возвращает имя пользователя, сохранённое SetUser
+func UserFromContext(ctx context.Context) string {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		return info.user
+	}
+	return ""
+}
+
+// AccessLog пишет по одной записи на каждый обработанный запрос
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		route := ""
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			route = rctx.RoutePattern()
+		}
+		user := UserFromContext(r.Context())
+
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		FromContext(r.Context()).LogAttrs(r.Context(), level, "http request",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Duration("duration", time.Since(start)),
+			slog.Int("bytes", ww.BytesWritten()),
+			slog.String("user", user),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+	})
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector снимает статистику пула соединений pgx в момент опроса
+type poolCollector struct {
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	totalConns      *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquireCount    *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquire    *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+	newConnsCount   *prometheus.Desc
+}
+
+func newPoolCollector() *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		acquiredConns:   desc("acquired_connections", "Количество занятых соединений."),
+		idleConns:       desc("idle_connections", "Количество свободных соединений."),
+		totalConns:      desc("total_connections", "Общее количество соединений в пуле."),
+		maxConns:        desc("max_connections", "Максимальный размер пула."),
+		acquireCount:    desc("acquire_total", "Количество успешных получений соединения из пула."),
+		acquireDuration: desc("acquire_duration_seconds_total", "Суммарное время ожидания соединения."),
+		emptyAcquire:    desc("empty_acquire_total", "Количество получений соединения, которым пришлось ждать."),
+		canceledAcquire: desc("canceled_acquire_total", "Количество отменённых получений соединения."),
+		newConnsCount:   desc("new_connections_total", "Количество открытых соединений."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.canceledAcquire
+	ch <- c.newConnsCount
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := database.PoolStat()
+	if stat == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.newConnsCount, prometheus.CounterValue, float64(stat.NewConnsCount()))
+}
+
+// tenderCollector считает тендеры по статусу и типу услуги прямо из базы данных
+type tenderCollector struct {
+	tenders *prometheus.Desc
+}
+
+func newTenderCollector() *tenderCollector {
+	return &tenderCollector{
+		tenders: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "tenders"),
+			"Количество тендеров по статусу и типу услуги.",
+			[]string{"status", "service_type"}, nil,
+		),
+	}
+}
+
+func (c *tenderCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tenders
+}
+
+func (c *tenderCollector) Collect(ch chan<- prometheus.Metric) {
+	if database.PoolStat() == nil {
+		return
+	}
+	ctx := context.Background()
+	counts, err := database.CountTendersByStatusAndServiceType(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to count tenders for metrics", "error", err)
+		return
+	}
+	for _, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.tenders, prometheus.GaugeValue, float64(count.Count), string(count.Status), string(count.ServiceType))
+	}
+}
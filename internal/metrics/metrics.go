@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"net/http"
+	"tender-service/internal/models"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "tender_service"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Количество HTTP-запросов по маршруту, методу и коду ответа.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Время обработки HTTP-запросов по маршруту, методу и коду ответа.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	grpcRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Количество gRPC-вызовов по методу и коду ответа.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Время обработки унарных gRPC-вызовов по методу и коду ответа.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	bidsSubmittedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bids_submitted_total",
+		Help:      "Количество созданных предложений по типу автора.",
+	}, []string{"author_type"})
+
+	decisionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bid_decisions_total",
+		Help:      "Количество решений ответственных по предложениям.",
+	}, []string{"decision"})
+
+	bidOutcomesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bid_outcomes_total",
+		Help:      "Количество предложений, получивших итоговое согласование.",
+	}, []string{"coordination"})
+
+	timeToAward = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tender_time_to_award_seconds",
+		Help:      "Время от создания тендера до одобрения предложения-победителя.",
+		Buckets:   []float64{3600, 6 * 3600, 24 * 3600, 3 * 24 * 3600, 7 * 24 * 3600, 14 * 24 * 3600, 30 * 24 * 3600, 90 * 24 * 3600},
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		grpcRequestsTotal,
+		grpcRequestDuration,
+		bidsSubmittedTotal,
+		decisionsTotal,
+		bidOutcomesTotal,
+		timeToAward,
+		newPoolCollector(),
+		newTenderCollector(),
+	)
+}
+
+// Handler отдаёт метрики в формате Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// GRPCRequest учитывает завершённый gRPC-вызов. Для потоковых вызовов duration не передаётся (0):
+// их длительность определяет клиент, и в гистограмме она только мешает.
+func GRPCRequest(method, code string, duration time.Duration) {
+	grpcRequestsTotal.WithLabelValues(method, code).Inc()
+	if duration > 0 {
+		grpcRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+	}
+}
+
+func BidSubmitted(authorType models.AuthorType) {
+	bidsSubmittedTotal.WithLabelValues(string(authorType)).Inc()
+}
+
+func DecisionSubmitted(decision models.Сoordination) {
+	decisionsTotal.WithLabelValues(string(decision)).Inc()
+}
+
+func BidCoordinated(coordination models.Сoordination) {
+	bidOutcomesTotal.WithLabelValues(string(coordination)).Inc()
+}
+
+// TenderAwarded фиксирует время от создания тендера до выбора победителя
+func TenderAwarded(tenderCreatedAt time.Time) {
+	timeToAward.Observe(time.Since(tenderCreatedAt).Seconds())
+}
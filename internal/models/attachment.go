@@ -0,0 +1,39 @@
+package models
+
+import (
+	"io"
+	"time"
+)
+
+// Attachment — файл, прикреплённый к тендеру или предложению. Заполнено ровно одно из TenderID и BidID.
+// Содержимое лежит в хранилище под ключом StorageKey, здесь только метаданные.
+type Attachment struct {
+	ID          string
+	TenderID    string
+	BidID       string
+	Name        string
+	ContentType string
+	Size        int64
+	Checksum    string // SHA-256 содержимого в шестнадцатеричном виде
+	StorageKey  string
+	Version     int // версия тендера или предложения на момент загрузки
+	UploadedBy  string
+	CreatedAt   time.Time
+}
+
+// AttachmentUpload — загружаемый файл; Content читается потоком и не буферизуется целиком
+type AttachmentUpload struct {
+	Name        string
+	ContentType string
+	Content     io.Reader
+}
+
+type AttachmentResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+	Version     int    `json:"version"` // Версия тендера или предложения, к которой относится файл
+	CreatedAt   string `json:"createdAt"`
+}
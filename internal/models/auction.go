@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+type AuctionStatus string
+
+const (
+	AuctionScheduled AuctionStatus = "Scheduled" // Торги ещё не начались
+	AuctionRunning   AuctionStatus = "Running"
+	AuctionClosed    AuctionStatus = "Closed" // Время торгов истекло; победитель определяется при закрытии
+)
+
+// Auction — обратный аукцион по тендеру: участники снижают цены своих предложений,
+// а при закрытии тендер присуждается предложению с наименьшей ценой
+type Auction struct {
+	TenderID     string
+	StartsAt     time.Time
+	EndsAt       time.Time // сдвигается продлением при ставках в конце торгов
+	MinDecrement float64
+	Extension    int        // продление в секундах при ставке меньше чем за Extension секунд до конца
+	ClosedAt     *time.Time // момент закрытия торгов и определения победителя
+	AwardedBidID string     // пусто, пока торги не закрыты или если ставок не было
+}
+
+// AuctionBid — текущая цена предложения на торгах
+type AuctionBid struct {
+	BidID      string
+	TenderID   string
+	AuthorType AuthorType
+	AuthorID   string
+	Price      float64
+	UpdatedAt  time.Time
+}
+
+type AuctionRequest struct {
+	StartsAt     time.Time `json:"startsAt"`
+	EndsAt       time.Time `json:"endsAt"`
+	MinDecrement float64   `json:"minDecrement"`               // Минимальный шаг снижения цены
+	Extension    int       `json:"extensionSeconds,omitempty"` // Продление торгов при поздней ставке, в секундах
+}
+
+type AuctionPriceRequest struct {
+	Price float64 `json:"price"`
+}
+
+type AuctionResponse struct {
+	TenderID     string                 `json:"tenderId"`
+	StartsAt     string                 `json:"startsAt"`
+	EndsAt       string                 `json:"endsAt"`
+	MinDecrement float64                `json:"minDecrement"`
+	Extension    int                    `json:"extensionSeconds"`
+	Status       AuctionStatus          `json:"status"`
+	ClosedAt     string                 `json:"closedAt,omitempty"`
+	AwardedBidID string                 `json:"awardedBidId,omitempty"`
+	Entries      []AuctionEntryResponse `json:"entries,omitempty"` // Только для ответственных за организацию тендера
+}
+
+type AuctionEntryResponse struct {
+	Rank       int        `json:"rank"`
+	BidID      string     `json:"bidId"`
+	AuthorType AuthorType `json:"authorType"`
+	AuthorID   string     `json:"authorId"`
+	Price      float64    `json:"price"`
+	UpdatedAt  string     `json:"updatedAt"`
+}
+
+// AuctionPositionResponse — положение предложения на торгах для его автора; участники-конкуренты не раскрываются
+type AuctionPositionResponse struct {
+	BidID        string          `json:"bidId"`
+	Price        *float64        `json:"price,omitempty"` // Нет, пока автор не назвал цену
+	Rank         int             `json:"rank,omitempty"`
+	Participants int             `json:"participants"`
+	BestPrice    *float64        `json:"bestPrice,omitempty"`
+	Auction      AuctionResponse `json:"auction"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditTenderOpened       AuditAction = "TenderOpened"       // Вскрытие предложений запечатанного тендера
+	AuditAuctionClosed      AuditAction = "AuctionClosed"      // Закрытие торгов и присуждение по наименьшей цене
+	AuditFeedbackHidden     AuditAction = "FeedbackHidden"     // Модератор скрыл отзыв или ответ на него
+	AuditFeedbackRestored   AuditAction = "FeedbackRestored"   // Модератор вернул скрытый отзыв или ответ
+	AuditAwardStatusChanged AuditAction = "AwardStatusChanged" // Смена статуса договора по присуждению
+	AuditBidWithdrawn       AuditAction = "BidWithdrawn"       // Автор отозвал предложение
+	AuditBidResubmitted     AuditAction = "BidResubmitted"     // Автор снова подал отозванное предложение
+)
+
+// AuditEvent — запись журнала значимых событий тендера. ActorID пуст, если событие произошло
+// без участия пользователя, например при фоновом вскрытии или закрытии торгов.
+type AuditEvent struct {
+	ID         string
+	TenderID   string
+	EntityType string
+	EntityID   string
+	Action     AuditAction
+	ActorID    string
+	Details    json.RawMessage
+	CreatedAt  time.Time
+}
+
+type AuditEventResponse struct {
+	ID         string          `json:"id"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId"`
+	Action     AuditAction     `json:"action"`
+	ActorID    string          `json:"actorId,omitempty"`
+	Details    json.RawMessage `json:"details,omitempty"`
+	CreatedAt  string          `json:"createdAt"`
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// AwardStatus — состояние договора по присуждению
+type AwardStatus string
+
+const (
+	AwardPendingSignature AwardStatus = "PendingSignature" // Ожидает подписания поставщиком
+	AwardActive           AwardStatus = "Active"           // Договор подписан и исполняется
+	AwardCompleted        AwardStatus = "Completed"        // Договор исполнен
+	AwardTerminated       AwardStatus = "Terminated"       // Договор расторгнут или не подписан
+)
+
+// Award — присуждение тендера (или лота) предложению: создаётся при одобрении предложения
+// и фиксирует одобренную версию и цену. Для тендера без лотов и торгов цена не известна.
+type Award struct {
+	ID           string
+	TenderID     string
+	LotID        string // пусто для тендера без лотов
+	BidID        string
+	BidVersion   int
+	Price        *float64
+	Status       AwardStatus
+	StatusReason string // причина последней смены статуса, например расторжения
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AwardMilestone — этап исполнения договора со сроком
+type AwardMilestone struct {
+	ID          string
+	AwardID     string
+	Name        string
+	Description string
+	DueDate     time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// AwardStatusRequest — причина смены статуса договора и, при завершении, отзыв о поставщике
+type AwardStatusRequest struct {
+	Reason   string           `json:"reason,omitempty"`
+	Feedback *FeedbackRequest `json:"feedback,omitempty"` // Только при переводе в Completed
+}
+
+type AwardMilestoneRequest struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	DueDate     *time.Time `json:"dueDate"`
+}
+
+type AwardResponse struct {
+	ID           string                   `json:"id"`
+	TenderID     string                   `json:"tenderId"`
+	LotID        string                   `json:"lotId,omitempty"`
+	BidID        string                   `json:"bidId"`
+	BidVersion   int                      `json:"bidVersion"`
+	Price        *float64                 `json:"price,omitempty"`
+	Status       AwardStatus              `json:"status"`
+	StatusReason string                   `json:"statusReason,omitempty"`
+	CreatedAt    string                   `json:"createdAt"`
+	UpdatedAt    string                   `json:"updatedAt"`
+	Milestones   []AwardMilestoneResponse `json:"milestones"`
+}
+
+type AwardMilestoneResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	DueDate     string `json:"dueDate"`
+	CompletedAt string `json:"completedAt,omitempty"`
+	Overdue     bool   `json:"overdue"` // Срок прошёл, а этап не выполнен
+}
@@ -15,6 +15,7 @@ const (
 	Created   Status = "Created"
 	Published Status = "Published"
 	Closed    Status = "Closed"
+	Canceled  Status = "Canceled" // Отменено автором, только для предложений
 )
 
 const (
@@ -38,6 +39,9 @@ type Bid struct {
 	Сoordination Сoordination
 	UserDecision []UserDecision
 	CreatedAt    time.Time
+	// SealedPayload — зашифрованный SealedBid для запечатанного тендера до вскрытия;
+	// в Name и Description в это время лежат заглушки
+	SealedPayload []byte
 }
 
 type BidEditRequest struct {
@@ -46,50 +50,95 @@ type BidEditRequest struct {
 }
 
 type BidHistory struct {
-	ID          string
-	BidID       string
-	Name        string
-	Description string
-	Version     int
+	ID            string
+	BidID         string
+	Name          string
+	Description   string
+	Version       int
+	SealedPayload []byte
 }
 
 type UserDecision struct {
 	ID         string
 	UserID     string
 	BidID      string
+	LotID      string // пусто для тендера без лотов
+	BidVersion int    // версия предложения, по которой принято решение
 	Decision   Сoordination
+	Comment    string
 	Created_at time.Time
+	// InvalidatedAt задан, если решение больше не учитывается: его отозвал автор (тогда задан и RevokedAt)
+	// или оно аннулировано при повторной подаче изменённого предложения
+	InvalidatedAt *time.Time
+	RevokedAt     *time.Time
+	Username      string // заполняется только при выборке истории решений
+}
+
+// BidDecisionResponse — решение в истории решений по предложению. Автору предложения
+// показываются только решения с комментарием и без указания, кто их принял.
+type BidDecisionResponse struct {
+	ID            string       `json:"id"`
+	BidID         string       `json:"bidId"`
+	LotID         string       `json:"lotId,omitempty"`
+	BidVersion    int          `json:"bidVersion"`
+	Author        string       `json:"author,omitempty"`
+	Decision      Сoordination `json:"decision"`
+	Comment       string       `json:"comment,omitempty"`
+	CreatedAt     string       `json:"createdAt"`
+	RevokedAt     string       `json:"revokedAt,omitempty"`
+	InvalidatedAt string       `json:"invalidatedAt,omitempty"`
 }
 type Feedback struct {
 	ID          string
 	UserID      string
 	BidID       string
 	BidFeedback string
+	Ratings     *FeedbackRatings // nil у текстовых отзывов без оценок
 	CreatedAt   time.Time
+	HiddenAt    *time.Time // задан, если отзыв скрыт модератором
+}
+
+// FeedbackRatings — оценки исполнителя от 1 до 5
+type FeedbackRatings struct {
+	Quality       int `json:"quality"`
+	Timeliness    int `json:"timeliness"`
+	Communication int `json:"communication"`
+}
+
+type FeedbackRequest struct {
+	Description string           `json:"description"`
+	Ratings     *FeedbackRatings `json:"ratings,omitempty"`
 }
 
 type FeedbackResponse struct {
-	ID          string `json:"id"`
-	Description string `json:"description"`
-	CreatedAt   string `json:"createdAt"`
+	ID          string                  `json:"id"`
+	Description string                  `json:"description"`
+	Ratings     *FeedbackRatings        `json:"ratings,omitempty"`
+	CreatedAt   string                  `json:"createdAt"`
+	Replies     []FeedbackReplyResponse `json:"replies,omitempty"` // Ответы автора предложения, кроме скрытых модератором
 }
 
 type BidResponse struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	Status      Status     `json:"status"`
-	TenderID    string     `json:"tenderId"`
-	AuthorType  AuthorType `json:"authorType"`
-	AuthorID    string     `json:"authorId"`
-	Version     int        `json:"version"`
-	CreatedAt   string     `json:"createdAt"`
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Status      Status           `json:"status"`
+	TenderID    string           `json:"tenderId"`
+	AuthorType  AuthorType       `json:"authorType"`
+	AuthorID    string           `json:"authorId"`
+	Version     int              `json:"version"`
+	CreatedAt   string           `json:"createdAt"`
+	Lots        []BidLotResponse `json:"lots,omitempty"`
+	Sealed      bool             `json:"sealed,omitempty"` // Содержимое скрыто от всех, кроме автора, до вскрытия тендера
+	// Репутация автора; заполняется только в списке предложений для ответственных за организацию тендера
+	AuthorReputation *ReputationResponse `json:"authorReputation,omitempty"`
 }
 
 type BidRequest struct {
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	TenderID    string     `json:"tenderId"`
-	AuthorType  AuthorType `json:"authorType"`
-	AuthorID    string     `json:"authorId"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	TenderID    string          `json:"tenderId"`
+	AuthorType  AuthorType      `json:"authorType"`
+	AuthorID    string          `json:"authorId"`
+	Lots        []BidLotRequest `json:"lots,omitempty"` // Обязательно для тендера с лотами
 }
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Clarification — вопрос участника по опубликованному тендеру и ответ организации.
+// Ответ публикуется всем участникам без указания автора вопроса.
+type Clarification struct {
+	ID         string
+	TenderID   string
+	AuthorID   string
+	Question   string
+	Answer     string // пусто, пока на вопрос не ответили
+	AnsweredBy string
+	CreatedAt  time.Time
+	AnsweredAt *time.Time
+}
+
+type ClarificationRequest struct {
+	Question string `json:"question"`
+}
+
+type ClarificationAnswerRequest struct {
+	Answer string `json:"answer"`
+}
+
+// ClarificationResponse не содержит автора вопроса: участники видят только, какие вопросы задали они сами
+type ClarificationResponse struct {
+	ID         string `json:"id"`
+	Question   string `json:"question"`
+	Answer     string `json:"answer,omitempty"`
+	Mine       bool   `json:"mine,omitempty"` // Вопрос задан текущим пользователем
+	CreatedAt  string `json:"createdAt"`
+	AnsweredAt string `json:"answeredAt,omitempty"`
+}
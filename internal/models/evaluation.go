@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Criterion — критерий оценки предложений по тендеру (цена, сроки, опыт, качество и т. п.).
+// Вес задаёт долю критерия в итоговой оценке; веса нормируются на их сумму.
+type Criterion struct {
+	ID          string
+	TenderID    string
+	Position    int
+	Name        string
+	Description string
+	Weight      float64
+	CreatedAt   time.Time
+}
+
+// BidScore — оценка предложения по одному критерию от одного ответственного
+type BidScore struct {
+	BidID       string
+	CriterionID string
+	EvaluatorID string
+	Score       int
+	UpdatedAt   time.Time
+}
+
+type CriterionRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight"` // Вес критерия, больше нуля
+}
+
+type CriterionResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight"`
+}
+
+type BidScoreRequest struct {
+	CriterionID string `json:"criterionId"`
+	Score       int    `json:"score"` // Оценка от 0 до 10
+}
+
+type BidScoresRequest struct {
+	Scores []BidScoreRequest `json:"scores"` // Оценки по всем критериям тендера
+}
+
+type BidScoreResponse struct {
+	CriterionID string `json:"criterionId"`
+	Score       int    `json:"score"`
+}
+
+// BidEvaluationResponse — оценки предложения, выставленные одним ответственным
+type BidEvaluationResponse struct {
+	BidID  string             `json:"bidId"`
+	Scores []BidScoreResponse `json:"scores"`
+}
+
+// CriterionAverage — средняя оценка предложения по критерию среди всех оценивших
+type CriterionAverage struct {
+	CriterionID string  `json:"criterionId"`
+	Average     float64 `json:"average"`
+}
+
+// RankingEntry — строка рейтинга предложений. Rank и Score не заполняются, пока предложение никто не оценил.
+type RankingEntry struct {
+	Rank         int                `json:"rank,omitempty"`
+	BidID        string             `json:"bidId"`
+	BidName      string             `json:"bidName"`
+	AuthorType   AuthorType         `json:"authorType"`
+	AuthorID     string             `json:"authorId"`
+	Status       Status             `json:"status"`
+	Coordination Сoordination       `json:"coordination"`
+	Score        *float64           `json:"score,omitempty"` // Взвешенная оценка от 0 до 10
+	Evaluators   int                `json:"evaluators"`      // Число ответственных, оценивших предложение
+	Criteria     []CriterionAverage `json:"criteria"`
+}
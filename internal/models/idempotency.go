@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// IdempotencyKey — сохранённый результат запроса с заголовком Idempotency-Key.
+// StatusCode равен нулю, пока первый запрос ещё выполняется.
+type IdempotencyKey struct {
+	UserKey      string
+	Key          string
+	RequestHash  string
+	StatusCode   int
+	ContentType  string
+	ResponseBody []byte
+	CreatedAt    time.Time
+}
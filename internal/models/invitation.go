@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "Pending"  // Ожидает ответа
+	InvitationAccepted InvitationStatus = "Accepted" // Принято: можно подавать предложения
+	InvitationDeclined InvitationStatus = "Declined" // Отклонено
+)
+
+// TenderInvitation — приглашение к тендеру только по приглашениям. Адресат — пользователь (UserID)
+// или организация (OrganizationID); за организацию отвечают её ответственные.
+type TenderInvitation struct {
+	ID             string
+	TenderID       string
+	TenderName     string // заполняется при выборке
+	UserID         string
+	Username       string // заполняется при выборке
+	OrganizationID string
+	Status         InvitationStatus
+	InvitedBy      string
+	RespondedBy    string
+	CreatedAt      time.Time
+	RespondedAt    *time.Time
+}
+
+// TenderInvitationRequest — адресат приглашения: ровно одно из полей
+type TenderInvitationRequest struct {
+	Username       string `json:"username,omitempty"`
+	OrganizationID string `json:"organizationId,omitempty"`
+}
+
+type TenderInvitationResponse struct {
+	ID             string           `json:"id"`
+	TenderID       string           `json:"tenderId"`
+	TenderName     string           `json:"tenderName"`
+	Username       string           `json:"username,omitempty"`
+	OrganizationID string           `json:"organizationId,omitempty"`
+	Status         InvitationStatus `json:"status"`
+	CreatedAt      string           `json:"createdAt"`
+	RespondedAt    string           `json:"respondedAt,omitempty"`
+}
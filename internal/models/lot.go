@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// Lot — часть тендера, которая присуждается независимо от остальных (например, поставка в один регион).
+// Тендер без лотов присуждается целиком одному предложению.
+type Lot struct {
+	ID           string
+	TenderID     string
+	Position     int
+	Name         string
+	Description  string
+	Quantity     int
+	Budget       float64
+	AwardedBidID string // пусто, пока лот не присуждён
+	CreatedAt    time.Time
+}
+
+// BidLot — участие предложения в лоте: цена и решение по этому лоту
+type BidLot struct {
+	BidID        string
+	LotID        string
+	Price        float64
+	Сoordination Сoordination
+}
+
+type LotRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	Budget      float64 `json:"budget"` // Максимальная стоимость лота
+}
+
+type LotResponse struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Quantity     int     `json:"quantity"`
+	Budget       float64 `json:"budget"`
+	AwardedBidID string  `json:"awardedBidId,omitempty"` // Предложение, которому присуждён лот
+}
+
+type BidLotRequest struct {
+	LotID string  `json:"lotId"`
+	Price float64 `json:"price"` // Цена за весь лот
+}
+
+type BidLotResponse struct {
+	LotID        string       `json:"lotId"`
+	Price        float64      `json:"price"`
+	Coordination Сoordination `json:"coordination"`
+}
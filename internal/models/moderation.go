@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+// FeedbackReply — ответ автора предложения на отзыв; ответы на один отзыв образуют его ветку
+type FeedbackReply struct {
+	ID         string
+	FeedbackID string
+	AuthorID   string
+	Username   string
+	Text       string
+	CreatedAt  time.Time
+	HiddenAt   *time.Time // задан, если ответ скрыт модератором
+}
+
+type FeedbackReplyRequest struct {
+	Text string `json:"text"`
+}
+
+type FeedbackReplyResponse struct {
+	ID         string `json:"id"`
+	FeedbackID string `json:"feedbackId"`
+	Author     string `json:"author"`
+	Text       string `json:"text"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// FeedbackReport — жалоба на отзыв или, если задан ReplyID, на ответ к нему.
+// Жалоба закрывается, когда модератор скрывает или восстанавливает содержимое.
+type FeedbackReport struct {
+	ID         string
+	FeedbackID string
+	ReplyID    string
+	ReporterID string
+	Reason     string
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+type FeedbackReportRequest struct {
+	Reason string `json:"reason"`
+}
+
+type FeedbackReportResponse struct {
+	ID         string `json:"id"`
+	FeedbackID string `json:"feedbackId"`
+	ReplyID    string `json:"replyId,omitempty"`
+	Reason     string `json:"reason"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// ModerationRequest — причина скрытия или восстановления, попадает в журнал событий тендера
+type ModerationRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ModerationItem — отзыв или ответ (если задан ReplyID) с открытыми жалобами
+type ModerationItem struct {
+	FeedbackID      string
+	ReplyID         string
+	BidID           string
+	TenderID        string
+	Text            string
+	Reports         int
+	Reasons         []string
+	FirstReportedAt time.Time
+	HiddenAt        *time.Time
+}
+
+type ModerationItemResponse struct {
+	FeedbackID      string   `json:"feedbackId"`
+	ReplyID         string   `json:"replyId,omitempty"`
+	BidID           string   `json:"bidId"`
+	TenderID        string   `json:"tenderId"`
+	Text            string   `json:"text"`
+	Reports         int      `json:"reports"`
+	Reasons         []string `json:"reasons,omitempty"`
+	FirstReportedAt string   `json:"firstReportedAt,omitempty"`
+	Hidden          bool     `json:"hidden"`
+}
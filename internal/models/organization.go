@@ -18,3 +18,9 @@ type Organization struct {
 	CreatedAt   time.Time        `json:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at"`
 }
+
+// OrganizationResponsible — пользователь, ответственный за организацию
+type OrganizationResponsible struct {
+	UserID         string
+	OrganizationID string
+}
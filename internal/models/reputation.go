@@ -0,0 +1,29 @@
+package models
+
+// Reputation — оценки из отзывов на предложения автора по всем тендерам.
+// Автор определяется так же, как в предложениях: типом и ID.
+type Reputation struct {
+	AuthorType    AuthorType
+	AuthorID      string
+	Reviews       int // число отзывов с оценками
+	Tenders       int // число тендеров, по которым есть такие отзывы
+	Quality       float64
+	Timeliness    float64
+	Communication float64
+}
+
+// RatingAverages — средние оценки; Overall — среднее по всем трём показателям
+type RatingAverages struct {
+	Quality       float64 `json:"quality"`
+	Timeliness    float64 `json:"timeliness"`
+	Communication float64 `json:"communication"`
+	Overall       float64 `json:"overall"`
+}
+
+type ReputationResponse struct {
+	AuthorType AuthorType      `json:"authorType"`
+	AuthorID   string          `json:"authorId"`
+	Reviews    int             `json:"reviews"`
+	Tenders    int             `json:"tenders"`
+	Ratings    *RatingAverages `json:"ratings,omitempty"` // Нет, пока у автора нет отзывов с оценками
+}
@@ -0,0 +1,16 @@
+package models
+
+// SealedBid — содержимое предложения по запечатанному тендеру, которое хранится зашифрованным до вскрытия
+type SealedBid struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Lots        []BidLotRequest `json:"lots,omitempty"`
+}
+
+// SealedPayload — зашифрованное содержимое предложения вместе с хранимым ключом его тендера
+type SealedPayload struct {
+	BidID     string
+	TenderID  string
+	SealedKey []byte
+	Payload   []byte
+}
@@ -12,6 +12,14 @@ const (
 	Manufacture  ServiceType = "Manufacture"
 )
 
+// TenderVisibility определяет, кто может видеть тендер и подавать на него предложения
+type TenderVisibility string
+
+const (
+	VisibilityPublic     TenderVisibility = "Public"     // Открытый тендер
+	VisibilityInviteOnly TenderVisibility = "InviteOnly" // Только для приглашённых пользователей и организаций
+)
+
 // версия 1
 type Tender struct {
 	ID                string
@@ -23,6 +31,11 @@ type Tender struct {
 	CreatorUsernameID string
 	Version           int
 	CreatedAt         time.Time
+	BidDeadline       *time.Time // nil — срок подачи предложений не ограничен
+	Sealed            bool       // содержимое предложений скрыто до вскрытия
+	SealedKey         []byte     // ключ тендера, зашифрованный мастер-ключом; удаляется при вскрытии
+	OpenedAt          *time.Time // момент вскрытия запечатанного тендера
+	Visibility        TenderVisibility
 }
 
 type TenderHistory struct {
@@ -35,26 +48,51 @@ type TenderHistory struct {
 }
 
 type TenderRequest struct {
-	Name            string      `json:"name"`            // Название тендера
-	Description     string      `json:"description"`     // Описание тендера
-	ServiceType     ServiceType `json:"serviceType"`     // Тип услуги, к которой относится тендер
-	OrganizationID  string      `json:"organizationId"`  // UUID организации, создавшей тендер
-	CreatorUsername string      `json:"creatorUsername"` // Имя пользователя, создавшего тендер (username)
+	Name            string          `json:"name"`                  // Название тендера
+	Description     string          `json:"description"`           // Описание тендера
+	ServiceType     ServiceType     `json:"serviceType"`           // Тип услуги, к которой относится тендер
+	OrganizationID  string          `json:"organizationId"`        // UUID организации, создавшей тендер
+	CreatorUsername string          `json:"creatorUsername"`       // Имя пользователя, создавшего тендер (username)
+	Lots            []LotRequest    `json:"lots,omitempty"`        // Лоты; без них тендер присуждается целиком
+	BidDeadline     *time.Time      `json:"bidDeadline,omitempty"` // Срок подачи предложений и вопросов
+	Sealed          bool            `json:"sealed,omitempty"`      // Скрывать содержимое предложений до срока подачи
+	Auction         *AuctionRequest `json:"auction,omitempty"`     // Обратный аукцион вместо разовых предложений
+	// Видимость тендера; по умолчанию Public. Задаётся только при создании
+	Visibility TenderVisibility `json:"visibility,omitempty"`
 }
 
 type TenderResponse struct {
-	ID             string      `json:"id"`          // Уникальный идентификатор тендера (UUID)
-	Name           string      `json:"name"`        // Название тендера
-	Description    string      `json:"description"` // Описание тендера
-	ServiceType    ServiceType `json:"serviceType"` // Тип услуги, к которой относится тендер
-	Status         Status      `json:"status"`      // Статус тендера (CREATED, PUBLISHED, CLOSED)
-	OrganizationID string      `json:"organizationId"`
-	Version        int         `json:"version"`   // Версия тендера для откатов и обновлений
-	CreatedAt      string      `json:"createdAt"` // Дата создания тендера
+	ID             string           `json:"id"`          // Уникальный идентификатор тендера (UUID)
+	Name           string           `json:"name"`        // Название тендера
+	Description    string           `json:"description"` // Описание тендера
+	ServiceType    ServiceType      `json:"serviceType"` // Тип услуги, к которой относится тендер
+	Status         Status           `json:"status"`      // Статус тендера (CREATED, PUBLISHED, CLOSED)
+	OrganizationID string           `json:"organizationId"`
+	Version        int              `json:"version"`   // Версия тендера для откатов и обновлений
+	CreatedAt      string           `json:"createdAt"` // Дата создания тендера
+	BidDeadline    string           `json:"bidDeadline,omitempty"`
+	Sealed         bool             `json:"sealed,omitempty"`
+	OpenedAt       string           `json:"openedAt,omitempty"` // Момент вскрытия запечатанного тендера
+	Visibility     TenderVisibility `json:"visibility"`
 }
 
 type TenderEditRequest struct {
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
 	ServiceType ServiceType `json:"serviceType"`
+	BidDeadline *time.Time  `json:"bidDeadline,omitempty"` // Срок не хранится в истории версий и не откатывается
+}
+
+// FormatTime возвращает время в формате RFC3339 или пустую строку, если оно не задано
+func FormatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+type TenderCount struct {
+	Status      Status
+	ServiceType ServiceType
+	Count       int
 }
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// BidWithdrawal — отзыв предложения автором. Пока ResubmittedAt не задан, предложение остаётся отозванным.
+type BidWithdrawal struct {
+	ID             string
+	BidID          string
+	Version        int // версия предложения на момент отзыва
+	Reason         string
+	WithdrawnBy    string
+	WithdrawnAt    time.Time
+	ResubmitReason string
+	ResubmittedAt  *time.Time
+	DecisionsReset bool // при повторной подаче прежние решения аннулированы, потому что предложение изменилось
+}
+
+type BidWithdrawalRequest struct {
+	Reason string `json:"reason"`
+}
+
+type BidWithdrawalResponse struct {
+	ID             string `json:"id"`
+	BidID          string `json:"bidId"`
+	Version        int    `json:"version"`
+	Reason         string `json:"reason"`
+	WithdrawnAt    string `json:"withdrawnAt"`
+	ResubmitReason string `json:"resubmitReason,omitempty"`
+	ResubmittedAt  string `json:"resubmittedAt,omitempty"` // Нет, пока предложение не подано повторно
+	DecisionsReset bool   `json:"decisionsReset"`
+}
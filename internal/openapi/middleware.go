@@ -0,0 +1,85 @@
+package openapi
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+// Middleware проверяет запросы к маршрутам, описанным в спецификации, и отклоняет
+// некорректные с кодом 400. Маршруты вне спецификации (метрики, health-checks) пропускаются.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, ok := v.findRoute(r)
+		if !ok || (!v.validateRequests && !v.validateResponses) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+				IncludeResponseStatus: true,
+				// Проверка тела multipart прочитала бы загружаемый файл в память целиком;
+				// такие тела разбирает и проверяет обработчик
+				ExcludeRequestBody: isMultipart(r),
+			},
+		}
+		if v.validateRequests {
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				reason := describe(err)
+				logger.FromContext(r.Context()).Info("request does not match openapi spec", "reason", reason)
+				writeError(w, http.StatusBadRequest, reason)
+				return
+			}
+		}
+		if !v.validateResponses {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		buffered := &bufferedResponse{header: w.Header()}
+		next.ServeHTTP(buffered, r)
+		if buffered.status == 0 {
+			buffered.status = http.StatusOK
+		}
+
+		err := openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 buffered.status,
+			Header:                 buffered.header,
+			Body:                   readCloser(buffered.body.Bytes()),
+			Options:                input.Options,
+		})
+		if err != nil {
+			reason := describe(err)
+			logger.FromContext(r.Context()).Error("response does not match openapi spec",
+				"reason", reason, "status", buffered.status, "body", buffered.body.String())
+			w.Header().Del("Content-Length")
+			writeError(w, http.StatusInternalServerError, reason)
+			return
+		}
+
+		w.WriteHeader(buffered.status)
+		w.Write(buffered.body.Bytes())
+	})
+}
+
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+func writeError(w http.ResponseWriter, code int, reason string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(models.ErrorResponse{Reason: reason})
+}
@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"tender-service/api"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// Validator проверяет запросы и ответы по встроенной спецификации api/openapi.yml
+type Validator struct {
+	router            routers.Router
+	basePath          string
+	validateRequests  bool
+	validateResponses bool
+}
+
+// New разбирает встроенную спецификацию. Проверка ответов предназначена для тестового режима:
+// любое расхождение ответа со схемой превращается в ошибку 500.
+func New(validateRequests, validateResponses bool) (*Validator, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(api.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка разбора спецификации OpenAPI: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("спецификация OpenAPI некорректна: %w", err)
+	}
+
+	// Пути в спецификации заданы относительно сервера /api. Маршрутизатор сопоставляет
+	// их без учёта хоста, поэтому префикс сервера отрезается от пути запроса в findRoute.
+	basePath := ""
+	if len(doc.Servers) > 0 {
+		serverURL, err := url.Parse(doc.Servers[0].URL)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный адрес сервера в спецификации OpenAPI: %w", err)
+		}
+		basePath = strings.TrimSuffix(serverURL.Path, "/")
+	}
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка построения маршрутов OpenAPI: %w", err)
+	}
+	return &Validator{
+		router:            router,
+		basePath:          basePath,
+		validateRequests:  validateRequests,
+		validateResponses: validateResponses,
+	}, nil
+}
+
+// SpecHandler отдаёт спецификацию OpenAPI
+func SpecHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(api.Spec)
+}
+
+func (v *Validator) findRoute(r *http.Request) (*routers.Route, map[string]string, bool) {
+	if !strings.HasPrefix(r.URL.Path, v.basePath+"/") {
+		return nil, nil, false
+	}
+	routed := r.Clone(r.Context())
+	routed.URL.Path = strings.TrimPrefix(r.URL.Path, v.basePath)
+	route, pathParams, err := v.router.FindRoute(routed)
+	if err != nil {
+		return nil, nil, false
+	}
+	return route, pathParams, true
+}
+
+// describe превращает ошибку валидации в короткое сообщение без дампа схемы
+func describe(err error) string {
+	var requestErr *openapi3filter.RequestError
+	if errors.As(err, &requestErr) {
+		reason := requestErr.Reason
+		var schemaErr *openapi3.SchemaError
+		if errors.As(requestErr.Err, &schemaErr) {
+			reason = schemaReason(schemaErr)
+		} else if reason == "" && requestErr.Err != nil {
+			reason = requestErr.Err.Error()
+		}
+		switch {
+		case requestErr.Parameter != nil:
+			return fmt.Sprintf("Некорректный параметр %s: %s", requestErr.Parameter.Name, reason)
+		case requestErr.RequestBody != nil:
+			return fmt.Sprintf("Некорректное тело запроса: %s", reason)
+		}
+		return fmt.Sprintf("Запрос не соответствует спецификации: %s", reason)
+	}
+
+	var responseErr *openapi3filter.ResponseError
+	if errors.As(err, &responseErr) {
+		reason := responseErr.Reason
+		var schemaErr *openapi3.SchemaError
+		if errors.As(responseErr.Err, &schemaErr) {
+			reason = schemaReason(schemaErr)
+		} else if responseErr.Err != nil {
+			reason = strings.TrimSpace(reason + " " + responseErr.Err.Error())
+		}
+		return fmt.Sprintf("Ответ не соответствует спецификации: %s", reason)
+	}
+	return err.Error()
+}
+
+func schemaReason(err *openapi3.SchemaError) string {
+	if path := err.JSONPointer(); len(path) > 0 {
+		return fmt.Sprintf("поле %s: %s", strings.Join(path, "."), err.Reason)
+	}
+	return err.Reason
+}
+
+// bufferedResponse накапливает ответ обработчика, чтобы проверить его до отправки клиенту
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) WriteHeader(code int) {
+	if b.status == 0 {
+		b.status = code
+	}
+}
+
+func readCloser(data []byte) io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(data))
+}
@@ -0,0 +1,113 @@
+// Package sealing шифрует содержимое предложений по запечатанным тендерам.
+// У каждого тендера свой ключ AES-256; в базе он хранится зашифрованным мастер-ключом сервиса,
+// поэтому доступ к базе без мастер-ключа не раскрывает содержимое предложений до вскрытия.
+package sealing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize — длина мастер-ключа и ключей тендеров в байтах (AES-256)
+const KeySize = 32
+
+// ErrDecrypt возвращается, если данные повреждены или зашифрованы другим ключом
+var ErrDecrypt = errors.New("не удалось расшифровать данные")
+
+// Keyring выпускает и раскрывает ключи тендеров с помощью мастер-ключа
+type Keyring struct {
+	master cipher.AEAD
+}
+
+// ParseMasterKey разбирает мастер-ключ, заданный в конфигурации в base64
+func ParseMasterKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("мастер-ключ должен быть в base64: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("мастер-ключ должен содержать %d байта, получено %d", KeySize, len(key))
+	}
+	return key, nil
+}
+
+func NewKeyring(masterKey []byte) (*Keyring, error) {
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Keyring{master: aead}, nil
+}
+
+// NewTenderKey создаёт ключ тендера и возвращает его вместе с зашифрованной для хранения копией
+func (k *Keyring) NewTenderKey(tenderID string) (key, wrapped []byte, err error) {
+	key = make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	wrapped, err = seal(k.master, key, "tender:"+tenderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, wrapped, nil
+}
+
+// TenderKey раскрывает хранимый ключ тендера
+func (k *Keyring) TenderKey(tenderID string, wrapped []byte) ([]byte, error) {
+	return open(k.master, wrapped, "tender:"+tenderID)
+}
+
+// SealBid шифрует содержимое предложения ключом тендера; ID предложения связывается с шифртекстом,
+// так что содержимое нельзя незаметно перенести в другое предложение
+func SealBid(tenderKey []byte, bidID string, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(tenderKey)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, plaintext, "bid:"+bidID)
+}
+
+// OpenBid расшифровывает содержимое предложения, зашифрованное SealBid
+func OpenBid(tenderKey []byte, bidID string, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(tenderKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, ciphertext, "bid:"+bidID)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("ключ должен содержать %d байта", KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal возвращает nonce, за которым следует шифртекст с тегом аутентификации
+func seal(aead cipher.AEAD, plaintext []byte, aad string) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(aad)), nil
+}
+
+func open(aead cipher.AEAD, data []byte, aad string) ([]byte, error) {
+	if len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrDecrypt
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(aad))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
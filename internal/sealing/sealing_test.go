@@ -0,0 +1,156 @@
+package sealing
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testKeyring(t *testing.T) *Keyring {
+	t.Helper()
+	keyring, err := NewKeyring(bytes.Repeat([]byte{7}, KeySize))
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	return keyring
+}
+
+func TestParseMasterKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr string
+	}{
+		{name: "корректный ключ", encoded: base64.StdEncoding.EncodeToString(make([]byte, KeySize))},
+		{name: "не base64", encoded: "not base64!", wantErr: "base64"},
+		{name: "короткий ключ", encoded: base64.StdEncoding.EncodeToString(make([]byte, 16)), wantErr: "получено 16"},
+		{name: "длинный ключ", encoded: base64.StdEncoding.EncodeToString(make([]byte, 64)), wantErr: "получено 64"},
+		{name: "пустая строка", encoded: "", wantErr: "получено 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParseMasterKey(tt.encoded)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseMasterKey() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMasterKey() error = %v", err)
+			}
+			if len(key) != KeySize {
+				t.Errorf("len(key) = %d, want %d", len(key), KeySize)
+			}
+		})
+	}
+}
+
+func TestNewKeyringRejectsWrongKeySize(t *testing.T) {
+	if _, err := NewKeyring(make([]byte, 16)); err == nil {
+		t.Error("NewKeyring() accepted 16-byte key")
+	}
+}
+
+func TestTenderKeyRoundTrip(t *testing.T) {
+	keyring := testKeyring(t)
+	key, wrapped, err := keyring.NewTenderKey("tender-1")
+	if err != nil {
+		t.Fatalf("NewTenderKey() error = %v", err)
+	}
+	if len(key) != KeySize {
+		t.Fatalf("len(key) = %d, want %d", len(key), KeySize)
+	}
+	if bytes.Contains(wrapped, key) {
+		t.Fatal("wrapped key contains plaintext key")
+	}
+
+	got, err := keyring.TenderKey("tender-1", wrapped)
+	if err != nil {
+		t.Fatalf("TenderKey() error = %v", err)
+	}
+	if !bytes.Equal(got, key) {
+		t.Error("TenderKey() returned a different key")
+	}
+
+	other, err := NewKeyring(bytes.Repeat([]byte{8}, KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		keyring  *Keyring
+		tenderID string
+		wrapped  []byte
+	}{
+		{name: "ключ другого тендера", keyring: keyring, tenderID: "tender-2", wrapped: wrapped},
+		{name: "другой мастер-ключ", keyring: other, tenderID: "tender-1", wrapped: wrapped},
+		{name: "обрезанные данные", keyring: keyring, tenderID: "tender-1", wrapped: wrapped[:10]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.keyring.TenderKey(tt.tenderID, tt.wrapped); !errors.Is(err, ErrDecrypt) {
+				t.Errorf("TenderKey() error = %v, want ErrDecrypt", err)
+			}
+		})
+	}
+}
+
+func TestSealOpenBid(t *testing.T) {
+	key, _, err := testKeyring(t).NewTenderKey("tender-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := []byte(`{"name":"Поставка","description":"Описание предложения"}`)
+
+	sealed, err := SealBid(key, "bid-1", plaintext)
+	if err != nil {
+		t.Fatalf("SealBid() error = %v", err)
+	}
+	again, err := SealBid(key, "bid-1", plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(sealed, again) {
+		t.Error("SealBid() reused nonce for identical input")
+	}
+
+	got, err := OpenBid(key, "bid-1", sealed)
+	if err != nil {
+		t.Fatalf("OpenBid() error = %v", err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("OpenBid() = %q, want %q", got, plaintext)
+	}
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+	otherKey := bytes.Repeat([]byte{1}, KeySize)
+
+	tests := []struct {
+		name  string
+		key   []byte
+		bidID string
+		data  []byte
+	}{
+		{name: "другое предложение", key: key, bidID: "bid-2", data: sealed},
+		{name: "другой ключ тендера", key: otherKey, bidID: "bid-1", data: sealed},
+		{name: "изменённый шифртекст", key: key, bidID: "bid-1", data: tampered},
+		{name: "данные короче nonce", key: key, bidID: "bid-1", data: sealed[:4]},
+		{name: "пустые данные", key: key, bidID: "bid-1", data: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := OpenBid(tt.key, tt.bidID, tt.data); !errors.Is(err, ErrDecrypt) {
+				t.Errorf("OpenBid() error = %v, want ErrDecrypt", err)
+			}
+		})
+	}
+
+	if _, err := SealBid(key[:16], "bid-1", plaintext); err == nil {
+		t.Error("SealBid() accepted 16-byte key")
+	}
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+	"tender-service/internal/storage"
+)
+
+// maxAttachments ограничивает число файлов у одного тендера или предложения
+const maxAttachments = 20
+
+const defaultContentType = "application/octet-stream"
+
+var (
+	attachmentStorage storage.Storage
+	maxAttachmentSize int64 = 20 << 20
+)
+
+// SetupAttachments задаёт хранилище содержимого вложений и предельный размер одного файла
+func SetupAttachments(store storage.Storage, maxSize int64) {
+	attachmentStorage = store
+	maxAttachmentSize = maxSize
+}
+
+// UploadTenderAttachment прикрепляет файл к тендеру. Загружать файлы могут ответственные
+// за организацию тендера, пока тендер не закрыт.
+func UploadTenderAttachment(ctx context.Context, tenderID, username string, upload *models.AttachmentUpload) (resp *models.AttachmentResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAttachmentsEditable(ctx, tender, user)
+
+	attachment := &models.Attachment{TenderID: tender.ID, Version: tender.Version, UploadedBy: user.ID}
+	storeAttachment(ctx, attachment, upload, "tenders/"+tender.ID)
+	return createAttachmentResponse(attachment), nil
+}
+
+// ListTenderAttachments возвращает файлы тендера; видимость та же, что у лотов тендера
+func ListTenderAttachments(ctx context.Context, tenderID, username string) (attachments []models.AttachmentResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра файлов тендера")
+
+	return listAttachments(ctx, tender.ID, ""), nil
+}
+
+// OpenTenderAttachment возвращает метаданные и содержимое файла тендера. Вызывающий обязан закрыть content.
+func OpenTenderAttachment(ctx context.Context, tenderID, attachmentID, username string) (attachment *models.Attachment, content io.ReadSeekCloser, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateID(attachmentID, "ID файла")
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра файлов тендера")
+
+	attachment = getAndValidateAttachment(ctx, attachmentID, tender.ID, "")
+	return attachment, openAttachment(ctx, attachment), nil
+}
+
+func DeleteTenderAttachment(ctx context.Context, tenderID, attachmentID, username string) (err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateID(attachmentID, "ID файла")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAttachmentsEditable(ctx, tender, user)
+
+	removeAttachment(ctx, getAndValidateAttachment(ctx, attachmentID, tender.ID, ""))
+	return nil
+}
+
+// UploadBidAttachment прикрепляет файл к предложению. Загружать файлы может только автор,
+// пока предложение не отменено и не закрыто.
+func UploadBidAttachment(ctx context.Context, bidID, username string, upload *models.AttachmentUpload) (resp *models.AttachmentResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAttachmentsEditable(bid, user)
+
+	attachment := &models.Attachment{BidID: bid.ID, Version: bid.Version, UploadedBy: user.ID}
+	storeAttachment(ctx, attachment, upload, "bids/"+bid.ID)
+	return createAttachmentResponse(attachment), nil
+}
+
+// ListBidAttachments возвращает файлы предложения автору и ответственным за организацию тендера
+func ListBidAttachments(ctx context.Context, bidID, username string) (attachments []models.AttachmentResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAccess(ctx, bid, user, "Недостаточно прав для просмотра файлов предложения")
+
+	return listAttachments(ctx, "", bid.ID), nil
+}
+
+// OpenBidAttachment возвращает метаданные и содержимое файла предложения. Вызывающий обязан закрыть content.
+func OpenBidAttachment(ctx context.Context, bidID, attachmentID, username string) (attachment *models.Attachment, content io.ReadSeekCloser, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateID(attachmentID, "ID файла")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAccess(ctx, bid, user, "Недостаточно прав для просмотра файлов предложения")
+
+	attachment = getAndValidateAttachment(ctx, attachmentID, "", bid.ID)
+	return attachment, openAttachment(ctx, attachment), nil
+}
+
+func DeleteBidAttachment(ctx context.Context, bidID, attachmentID, username string) (err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateID(attachmentID, "ID файла")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAttachmentsEditable(bid, user)
+
+	removeAttachment(ctx, getAndValidateAttachment(ctx, attachmentID, "", bid.ID))
+	return nil
+}
+
+func checkTenderAttachmentsEditable(ctx context.Context, tender *models.Tender, user *models.User) {
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для изменения файлов тендера")
+	}
+	if tender.Status == models.Closed {
+		fail(http.StatusForbidden, "Тендер закрыт, его файлы нельзя изменить")
+	}
+}
+
+func checkBidAttachmentsEditable(bid *models.Bid, user *models.User) {
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "Недостаточно прав для изменения файлов предложения")
+	}
+	if bid.Status == models.Closed || bid.Status == models.Canceled {
+		fail(http.StatusForbidden, "Предложение закрыто или отменено, его файлы нельзя изменить")
+	}
+}
+
+func getAttachmentStorage() storage.Storage {
+	if attachmentStorage == nil {
+		fail(http.StatusServiceUnavailable, "Хранилище файлов не настроено")
+	}
+	return attachmentStorage
+}
+
+// getAndValidateAttachment находит файл и проверяет, что он принадлежит указанному тендеру или предложению
+func getAndValidateAttachment(ctx context.Context, attachmentID, tenderID, bidID string) *models.Attachment {
+	attachment, err := database.GetAttachmentByID(ctx, attachmentID)
+	if err != nil && err != pgx.ErrNoRows {
+		fail(http.StatusInternalServerError, "Ошибка при получении файла")
+	}
+	if err == pgx.ErrNoRows || attachment.TenderID != tenderID || attachment.BidID != bidID {
+		fail(http.StatusNotFound, fmt.Sprintf("Файл с ID %s не найден", attachmentID))
+	}
+	return attachment
+}
+
+func listAttachments(ctx context.Context, tenderID, bidID string) []models.AttachmentResponse {
+	attachments, err := database.GetAttachments(ctx, tenderID, bidID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении файлов")
+	}
+	responses := make([]models.AttachmentResponse, len(attachments))
+	for i := range attachments {
+		responses[i] = *createAttachmentResponse(&attachments[i])
+	}
+	return responses
+}
+
+// storeAttachment записывает содержимое в хранилище под ключом prefix/<ID файла>, считая размер
+// и контрольную сумму на лету, и сохраняет метаданные. Если метаданные сохранить не удалось,
+// содержимое удаляется, чтобы в хранилище не оставалось файлов без записи в базе.
+func storeAttachment(ctx context.Context, attachment *models.Attachment, upload *models.AttachmentUpload, prefix string) {
+	store := getAttachmentStorage()
+
+	attachment.Name = validateFileName(upload.Name)
+	attachment.ContentType = normalizeContentType(upload.ContentType)
+
+	existing, err := database.GetAttachments(ctx, attachment.TenderID, attachment.BidID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении файлов")
+	}
+	if len(existing) >= maxAttachments {
+		fail(http.StatusBadRequest, fmt.Sprintf("Слишком много файлов, максимум %d", maxAttachments))
+	}
+
+	attachment.ID = uuid.NewString()
+	attachment.StorageKey = prefix + "/" + attachment.ID
+
+	// Читается на байт больше предела, чтобы отличить файл ровно предельного размера от большего
+	content := &uploadReader{r: io.LimitReader(upload.Content, maxAttachmentSize+1), hash: sha256.New()}
+	if err := store.Put(ctx, attachment.StorageKey, content, attachment.ContentType); err != nil {
+		if content.err != nil {
+			fail(http.StatusBadRequest, "Не удалось прочитать загружаемый файл")
+		}
+		logger.FromContext(ctx).Error("failed to store attachment", "key", attachment.StorageKey, "error", err)
+		fail(http.StatusInternalServerError, "Ошибка при сохранении файла")
+	}
+	if content.size > maxAttachmentSize || content.size == 0 {
+		deleteStoredContent(ctx, attachment.StorageKey)
+		if content.size == 0 {
+			fail(http.StatusBadRequest, "Файл пуст")
+		}
+		fail(http.StatusRequestEntityTooLarge, fmt.Sprintf("Размер файла превышает %d байт", maxAttachmentSize))
+	}
+	attachment.Size = content.size
+	attachment.Checksum = hex.EncodeToString(content.hash.Sum(nil))
+
+	if err := database.SaveAttachment(ctx, attachment); err != nil {
+		deleteStoredContent(ctx, attachment.StorageKey)
+		fail(http.StatusInternalServerError, "Ошибка при сохранении файла")
+	}
+}
+
+func openAttachment(ctx context.Context, attachment *models.Attachment) io.ReadSeekCloser {
+	content, err := getAttachmentStorage().Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			logger.FromContext(ctx).Error("attachment content is missing", "key", attachment.StorageKey)
+		} else {
+			logger.FromContext(ctx).Error("failed to open attachment", "key", attachment.StorageKey, "error", err)
+		}
+		fail(http.StatusInternalServerError, "Ошибка при чтении файла")
+	}
+	return content
+}
+
+// removeAttachment удаляет сначала запись, затем содержимое: сбой хранилища оставит
+// недоступный файл без записи, но не запись, ссылающуюся на удалённый файл
+func removeAttachment(ctx context.Context, attachment *models.Attachment) {
+	store := getAttachmentStorage()
+	if err := database.DeleteAttachment(ctx, attachment.ID); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при удалении файла")
+	}
+	if err := store.Delete(ctx, attachment.StorageKey); err != nil {
+		logger.FromContext(ctx).Error("failed to delete attachment content", "key", attachment.StorageKey, "error", err)
+	}
+}
+
+func deleteStoredContent(ctx context.Context, key string) {
+	if err := attachmentStorage.Delete(ctx, key); err != nil {
+		logger.FromContext(ctx).Error("failed to delete attachment content", "key", key, "error", err)
+	}
+}
+
+// validateFileName оставляет от имени файла только последний сегмент пути:
+// браузеры и клиенты иногда передают полный путь
+func validateFileName(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." {
+		fail(http.StatusBadRequest, "Не указано имя файла")
+	}
+	if !utf8.ValidString(name) || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		fail(http.StatusBadRequest, "Некорректное имя файла")
+	}
+	if utf8.RuneCountInString(name) > 255 {
+		fail(http.StatusBadRequest, "Имя файла слишком длинное, максимум 255 символов")
+	}
+	return name
+}
+
+func normalizeContentType(contentType string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return defaultContentType
+	}
+	normalized := mime.FormatMediaType(mediaType, params)
+	if normalized == "" || len(normalized) > 255 {
+		return defaultContentType
+	}
+	return normalized
+}
+
+// uploadReader считает размер и SHA-256 загружаемого содержимого и запоминает ошибку чтения,
+// чтобы отличить оборванную клиентом загрузку от сбоя хранилища
+type uploadReader struct {
+	r    io.Reader
+	hash hash.Hash
+	size int64
+	err  error
+}
+
+func (u *uploadReader) Read(p []byte) (int, error) {
+	n, err := u.r.Read(p)
+	u.hash.Write(p[:n])
+	u.size += int64(n)
+	if err != nil && err != io.EOF {
+		u.err = err
+	}
+	return n, err
+}
+
+func createAttachmentResponse(attachment *models.Attachment) *models.AttachmentResponse {
+	return &models.AttachmentResponse{
+		ID:          attachment.ID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Version:     attachment.Version,
+		CreatedAt:   attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+	"tender-service/internal/metrics"
+	"tender-service/internal/models"
+)
+
+// maxAuctionExtension ограничивает продление торгов при поздней ставке, в секундах
+const maxAuctionExtension = 3600
+
+// closeBatchSize ограничивает число торгов, закрываемых за один запуск фоновой задачи
+const closeBatchSize = 100
+
+// GetAuction возвращает расписание и состояние торгов по тендеру. Торги опубликованного тендера видны всем,
+// а ответственным за организацию тендера возвращается ещё и таблица цен участников.
+func GetAuction(ctx context.Context, tenderID, username string) (resp *models.AuctionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра торгов")
+
+	auction := closeAuctionIfDue(ctx, tender, getAndValidateAuction(ctx, tender.ID))
+	resp = createAuctionResponse(auction)
+	if username == "" {
+		return resp, nil
+	}
+	user := getAndValidateUserByUsername(ctx, username)
+	if database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		for i, bid := range getAuctionBids(ctx, tender.ID) {
+			resp.Entries = append(resp.Entries, models.AuctionEntryResponse{
+				Rank:       i + 1,
+				BidID:      bid.BidID,
+				AuthorType: bid.AuthorType,
+				AuthorID:   bid.AuthorID,
+				Price:      bid.Price,
+				UpdatedAt:  bid.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// SubmitAuctionPrice снижает цену предложения на идущих торгах. Первая цена может быть любой,
+// каждая следующая должна быть ниже текущей цены этого предложения не меньше чем на шаг аукциона.
+func SubmitAuctionPrice(ctx context.Context, bidID, username string, request *models.AuctionPriceRequest) (resp *models.AuctionPositionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	if request.Price <= 0 {
+		fail(http.StatusBadRequest, "Цена должна быть больше нуля")
+	}
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	auction := getAndValidateAuction(ctx, tender.ID)
+
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Торги идут только по опубликованному тендеру")
+	}
+	if bid.Status != models.Published {
+		fail(http.StatusForbidden, "Участвовать в торгах может только опубликованное предложение")
+	}
+	if time.Now().Before(auction.StartsAt) {
+		fail(http.StatusForbidden, "Торги по тендеру ещё не начались")
+	}
+	checkAuctionOpen(auction)
+
+	updated, err := database.SaveAuctionPrice(ctx, tender.ID, bid.ID, request.Price)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrAuctionNotRunning):
+			fail(http.StatusForbidden, "Торги по тендеру завершены")
+		case errors.Is(err, database.ErrPriceNotLower):
+			fail(http.StatusBadRequest, fmt.Sprintf("Новая цена должна быть ниже текущей не меньше чем на %.2f", auction.MinDecrement))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении цены")
+	}
+	return auctionPosition(ctx, bid.ID, updated), nil
+}
+
+// GetAuctionPosition возвращает автору место его предложения на торгах, не раскрывая других участников
+func GetAuctionPosition(ctx context.Context, bidID, username string) (resp *models.AuctionPositionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	auction := closeAuctionIfDue(ctx, tender, getAndValidateAuction(ctx, tender.ID))
+
+	return auctionPosition(ctx, bid.ID, auction), nil
+}
+
+// CloseDueAuctions закрывает торги, время которых истекло, и присуждает тендеры.
+// Торги, которые не удалось закрыть, попадают в лог и не мешают закрытию остальных.
+func CloseDueAuctions(ctx context.Context) error {
+	ids, err := database.GetDueAuctionTenderIDs(ctx, closeBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		tender, err := database.GetTenderByID(ctx, id)
+		if err == nil {
+			_, err = closeAuction(ctx, tender)
+		}
+		if err != nil {
+			logger.FromContext(ctx).Error("failed to close auction", "tender_id", id, "error", err)
+		}
+	}
+	return nil
+}
+
+// closeAuctionIfDue закрывает истёкшие торги, не дожидаясь фоновой задачи, и возвращает их актуальное состояние
+func closeAuctionIfDue(ctx context.Context, tender *models.Tender, auction *models.Auction) *models.Auction {
+	if auction.ClosedAt != nil || time.Now().Before(auction.EndsAt) {
+		return auction
+	}
+	closed, err := closeAuction(ctx, tender)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при закрытии торгов")
+	}
+	if closed == nil {
+		return getAndValidateAuction(ctx, tender.ID)
+	}
+	return closed
+}
+
+// closeAuction закрывает торги и сообщает об итогах подписчикам и в метрики.
+// Возвращает nil, если торги ещё не истекли или уже закрыты.
+func closeAuction(ctx context.Context, tender *models.Tender) (*models.Auction, error) {
+	event := &models.AuditEvent{
+		TenderID:   tender.ID,
+		EntityType: "tender",
+		EntityID:   tender.ID,
+		Action:     models.AuditAuctionClosed,
+	}
+	auction, settled, err := database.CloseAuction(ctx, tender.ID, event)
+	if err != nil || auction == nil {
+		return nil, err
+	}
+	for i := range settled {
+		metrics.BidCoordinated(settled[i].Сoordination)
+		publishBid(&settled[i], tender)
+	}
+	if auction.AwardedBidID != "" {
+		metrics.TenderAwarded(tender.CreatedAt)
+	}
+	logger.FromContext(ctx).Info("auction closed", "tender_id", tender.ID, "details", string(event.Details))
+	return auction, nil
+}
+
+func validateAuction(auction *models.AuctionRequest) {
+	if auction.StartsAt.IsZero() || auction.EndsAt.IsZero() {
+		fail(http.StatusBadRequest, "Укажите время начала и окончания торгов")
+	}
+	if !auction.EndsAt.After(auction.StartsAt) {
+		fail(http.StatusBadRequest, "Торги должны заканчиваться позже, чем начинаются")
+	}
+	if !auction.EndsAt.After(time.Now()) {
+		fail(http.StatusBadRequest, "Время окончания торгов должно быть в будущем")
+	}
+	if auction.MinDecrement <= 0 {
+		fail(http.StatusBadRequest, "Шаг снижения цены должен быть больше нуля")
+	}
+	if auction.Extension < 0 || auction.Extension > maxAuctionExtension {
+		fail(http.StatusBadRequest, fmt.Sprintf("Продление торгов должно быть от 0 до %d секунд", maxAuctionExtension))
+	}
+}
+
+// checkAuctionOpen запрещает действия, которые допустимы только до окончания торгов
+func checkAuctionOpen(auction *models.Auction) {
+	if auction.ClosedAt != nil || !time.Now().Before(auction.EndsAt) {
+		fail(http.StatusForbidden, "Торги по тендеру завершены")
+	}
+}
+
+func auctionPosition(ctx context.Context, bidID string, auction *models.Auction) *models.AuctionPositionResponse {
+	bids := getAuctionBids(ctx, auction.TenderID)
+	resp := &models.AuctionPositionResponse{
+		BidID:        bidID,
+		Participants: len(bids),
+		Auction:      *createAuctionResponse(auction),
+	}
+	if len(bids) > 0 {
+		resp.BestPrice = &bids[0].Price
+	}
+	for i := range bids {
+		if bids[i].BidID == bidID {
+			resp.Rank = i + 1
+			resp.Price = &bids[i].Price
+		}
+	}
+	return resp
+}
+
+// getTenderAuction возвращает торги по тендеру или nil, если тендер проводится без торгов
+func getTenderAuction(ctx context.Context, tenderID string) *models.Auction {
+	auction, err := database.GetAuctionByTenderID(ctx, tenderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении торгов")
+	}
+	return auction
+}
+
+func getAndValidateAuction(ctx context.Context, tenderID string) *models.Auction {
+	auction := getTenderAuction(ctx, tenderID)
+	if auction == nil {
+		fail(http.StatusNotFound, "Тендер проводится без торгов")
+	}
+	return auction
+}
+
+func getAuctionBids(ctx context.Context, tenderID string) []models.AuctionBid {
+	bids, err := database.GetAuctionBids(ctx, tenderID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении цен участников торгов")
+	}
+	return bids
+}
+
+func createAuctionResponse(auction *models.Auction) *models.AuctionResponse {
+	status := models.AuctionRunning
+	now := time.Now()
+	switch {
+	case auction.ClosedAt != nil || !now.Before(auction.EndsAt):
+		status = models.AuctionClosed
+	case now.Before(auction.StartsAt):
+		status = models.AuctionScheduled
+	}
+	return &models.AuctionResponse{
+		TenderID:     auction.TenderID,
+		StartsAt:     auction.StartsAt.Format(time.RFC3339),
+		EndsAt:       auction.EndsAt.Format(time.RFC3339),
+		MinDecrement: auction.MinDecrement,
+		Extension:    auction.Extension,
+		Status:       status,
+		ClosedAt:     models.FormatTime(auction.ClosedAt),
+		AwardedBidID: auction.AwardedBidID,
+	}
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// GetAward возвращает договор по присуждению с его этапами.
+// Доступно автору выигравшего предложения и ответственным за организацию тендера.
+func GetAward(ctx context.Context, awardID, username string) (resp *models.AwardResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(awardID, "ID договора")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	award := getAndValidateAward(ctx, awardID)
+	bid := getAndValidateBidByID(ctx, award.BidID)
+	checkBidAccess(ctx, bid, user, "Недостаточно прав для просмотра договора")
+
+	return awardResponse(ctx, award), nil
+}
+
+// ListTenderAwards возвращает договоры по тендеру. Ответственные за организацию тендера видят все договоры,
+// остальные пользователи — только по своим предложениям.
+func ListTenderAwards(ctx context.Context, tenderID, username string, limit, offset int) (awards []models.AwardResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	authorID := user.ID
+	if database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		authorID = ""
+	}
+
+	stored, err := database.GetAwardsByTenderID(ctx, tender.ID, authorID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении договоров")
+	}
+	return awardResponses(ctx, stored), nil
+}
+
+// UpdateAwardStatus меняет статус договора:
+//   - PendingSignature → Active: договор подписывает автор выигравшего предложения;
+//   - Active → Completed: исполнение принимает ответственный за организацию тендера, при этом можно
+//     оставить отзыв о поставщике с оценками, который учитывается в его репутации;
+//   - PendingSignature или Active → Terminated: расторжение ответственным с указанием причины;
+//     автор предложения может отказаться от подписания договора, тоже с причиной.
+func UpdateAwardStatus(ctx context.Context, awardID string, status models.AwardStatus, username string, request *models.AwardStatusRequest) (resp *models.AwardResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(awardID, "ID договора")
+	validateAwardStatus(status)
+	validateUsername(username)
+	validateReason(request.Reason, status == models.AwardTerminated)
+	if request.Feedback != nil {
+		if status != models.AwardCompleted {
+			fail(http.StatusBadRequest, "Отзыв о поставщике можно оставить только при завершении договора")
+		}
+		validateFeedback(request.Feedback.Description)
+		validateRatings(request.Feedback.Ratings)
+	}
+
+	user := getAndValidateUserByUsername(ctx, username)
+	award := getAndValidateAward(ctx, awardID)
+	bid := getAndValidateBidByID(ctx, award.BidID)
+	tender := getAndValidateTenderByID(ctx, award.TenderID)
+	responsible := database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID)
+	supplier := user.ID == bid.AuthorID
+	if !responsible && !supplier {
+		fail(http.StatusForbidden, "Недостаточно прав для изменения договора")
+	}
+
+	from := award.Status
+	switch {
+	case status == models.AwardActive && from == models.AwardPendingSignature:
+		if !supplier {
+			fail(http.StatusForbidden, "Подписать договор может только автор предложения")
+		}
+	case status == models.AwardCompleted && from == models.AwardActive:
+		if !responsible {
+			fail(http.StatusForbidden, "Завершить договор может только ответственный за организацию тендера")
+		}
+	case status == models.AwardTerminated && from == models.AwardPendingSignature:
+	case status == models.AwardTerminated && from == models.AwardActive:
+		if !responsible {
+			fail(http.StatusForbidden, "Расторгнуть подписанный договор может только ответственный за организацию тендера")
+		}
+	default:
+		fail(http.StatusConflict, "Договор в статусе "+string(from)+" нельзя перевести в "+string(status))
+	}
+
+	var feedback *models.Feedback
+	if request.Feedback != nil {
+		feedback = &models.Feedback{
+			UserID:      user.ID,
+			BidID:       bid.ID,
+			BidFeedback: request.Feedback.Description,
+			Ratings:     request.Feedback.Ratings,
+		}
+	}
+
+	award.Status, award.StatusReason = status, request.Reason
+	details, _ := json.Marshal(map[string]string{"from": string(from), "to": string(status), "reason": request.Reason})
+	event := &models.AuditEvent{
+		TenderID:   award.TenderID,
+		EntityType: "award",
+		EntityID:   award.ID,
+		Action:     models.AuditAwardStatusChanged,
+		ActorID:    user.ID,
+		Details:    details,
+	}
+	if err := database.UpdateAwardStatus(ctx, award, from, feedback, event); err != nil {
+		switch {
+		case errors.Is(err, database.ErrAwardState):
+			fail(http.StatusConflict, "Статус договора уже изменён")
+		case errors.Is(err, database.ErrFeedbackRated):
+			fail(http.StatusConflict, "Пользователь уже оценил это предложение")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при обновлении статуса договора")
+	}
+	return awardResponse(ctx, award), nil
+}
+
+// AddAwardMilestone добавляет этап исполнения договора. Этапы задаёт ответственный за организацию тендера,
+// пока договор не завершён и не расторгнут.
+func AddAwardMilestone(ctx context.Context, awardID, username string, request *models.AwardMilestoneRequest) (resp *models.AwardResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(awardID, "ID договора")
+	validateUsername(username)
+	validateMilestone(request)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	award := getAndValidateAward(ctx, awardID)
+	checkAwardResponsible(ctx, award, user)
+	if award.Status != models.AwardPendingSignature && award.Status != models.AwardActive {
+		fail(http.StatusConflict, "Договор уже завершён или расторгнут")
+	}
+
+	milestone := &models.AwardMilestone{
+		AwardID:     award.ID,
+		Name:        request.Name,
+		Description: request.Description,
+		DueDate:     *request.DueDate,
+	}
+	if err := database.SaveAwardMilestone(ctx, milestone); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении этапа договора")
+	}
+	return awardResponse(ctx, award), nil
+}
+
+// CompleteAwardMilestone отмечает этап подписанного договора выполненным; это делает ответственный
+// за организацию тендера
+func CompleteAwardMilestone(ctx context.Context, awardID, milestoneID, username string) (resp *models.AwardResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(awardID, "ID договора")
+	validateID(milestoneID, "ID этапа")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	award := getAndValidateAward(ctx, awardID)
+	checkAwardResponsible(ctx, award, user)
+	if award.Status != models.AwardActive {
+		fail(http.StatusConflict, "Этапы отмечаются только по подписанному договору")
+	}
+
+	if err := database.CompleteAwardMilestone(ctx, award.ID, milestoneID); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			fail(http.StatusNotFound, "Этап договора не найден")
+		case errors.Is(err, database.ErrAwardState):
+			fail(http.StatusConflict, "Этап уже выполнен")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при обновлении этапа договора")
+	}
+	return awardResponse(ctx, award), nil
+}
+
+// createAward сохраняет договор по одобренному предложению: для тендера без лотов lotID и price пусты
+func createAward(ctx context.Context, bid *models.Bid, lotID string, price *float64) {
+	award := &models.Award{
+		TenderID:   bid.TenderID,
+		LotID:      lotID,
+		BidID:      bid.ID,
+		BidVersion: bid.Version,
+		Price:      price,
+	}
+	if err := database.CreateAward(ctx, award); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении договора")
+	}
+}
+
+func checkAwardResponsible(ctx context.Context, award *models.Award, user *models.User) {
+	tender := getAndValidateTenderByID(ctx, award.TenderID)
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Управлять этапами договора может только ответственный за организацию тендера")
+	}
+}
+
+func getAndValidateAward(ctx context.Context, awardID string) *models.Award {
+	award, err := database.GetAwardByID(ctx, awardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			fail(http.StatusNotFound, "Договор не найден")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении договора")
+	}
+	return award
+}
+
+func awardResponse(ctx context.Context, award *models.Award) *models.AwardResponse {
+	return &awardResponses(ctx, []models.Award{*award})[0]
+}
+
+// awardResponses собирает ответы по договорам вместе с этапами одним запросом на весь список
+func awardResponses(ctx context.Context, awards []models.Award) []models.AwardResponse {
+	resp := make([]models.AwardResponse, len(awards))
+	if len(awards) == 0 {
+		return resp
+	}
+	ids := make([]string, len(awards))
+	index := make(map[string]int, len(awards))
+	for i, award := range awards {
+		ids[i] = award.ID
+		index[award.ID] = i
+		resp[i] = models.AwardResponse{
+			ID:           award.ID,
+			TenderID:     award.TenderID,
+			LotID:        award.LotID,
+			BidID:        award.BidID,
+			BidVersion:   award.BidVersion,
+			Price:        award.Price,
+			Status:       award.Status,
+			StatusReason: award.StatusReason,
+			CreatedAt:    award.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:    award.UpdatedAt.Format(time.RFC3339),
+			Milestones:   []models.AwardMilestoneResponse{},
+		}
+	}
+
+	milestones, err := database.GetAwardMilestones(ctx, ids)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении этапов договора")
+	}
+	now := time.Now()
+	for _, milestone := range milestones {
+		award := &resp[index[milestone.AwardID]]
+		award.Milestones = append(award.Milestones, models.AwardMilestoneResponse{
+			ID:          milestone.ID,
+			Name:        milestone.Name,
+			Description: milestone.Description,
+			DueDate:     milestone.DueDate.Format(time.RFC3339),
+			CompletedAt: models.FormatTime(milestone.CompletedAt),
+			Overdue:     milestone.CompletedAt == nil && milestone.DueDate.Before(now),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// Функции этого файла загружают данные сразу для набора ID для загрузчиков GraphQL и оставляют
+// только то, что пользователь получил бы через соответствующие REST-маршруты.
+// Недоступные записи пропускаются, как несуществующие.
+
+// VisibleTenders возвращает те тендеры из tenderIDs, которые viewer видит по правилам checkTenderAccess;
+// viewer равен nil, если запрос выполняется без пользователя
+func VisibleTenders(ctx context.Context, viewer *models.User, tenderIDs []string) (visible []models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	tenders, err := database.GetTendersByIDs(ctx, tenderIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении тендеров")
+	}
+
+	responsible := map[string]bool{}
+	invitations := map[string]models.InvitationStatus{}
+	if viewer != nil {
+		organizationIDs := make([]string, len(tenders))
+		for i, tender := range tenders {
+			organizationIDs[i] = tender.OrganizationID
+		}
+		responsible = responsibleOrganizations(ctx, viewer, organizationIDs)
+
+		invited := []string{}
+		for _, tender := range tenders {
+			if tender.Status == models.Published && tender.Visibility == models.VisibilityInviteOnly && !responsible[tender.OrganizationID] {
+				invited = append(invited, tender.ID)
+			}
+		}
+		if len(invited) > 0 {
+			stored, err := database.GetInvitationStatusesByTenderIDs(ctx, viewer.ID, invited)
+			if err != nil {
+				fail(http.StatusInternalServerError, "Ошибка при проверке приглашений")
+			}
+			for _, invitation := range stored {
+				invitations[invitation.TenderID] = invitation.Status
+			}
+		}
+	}
+
+	visible = []models.TenderResponse{}
+	for _, tender := range tenders {
+		if tenderVisible(tender.Status, tender.Visibility, responsible[tender.OrganizationID], invitations[tender.ID]) {
+			visible = append(visible, tender)
+		}
+	}
+	return visible, nil
+}
+
+// responsibleOrganizations возвращает множество тех из organizationIDs, за которые отвечает пользователь
+func responsibleOrganizations(ctx context.Context, user *models.User, organizationIDs []string) map[string]bool {
+	ids, err := database.GetResponsibleOrganizationIDs(ctx, user.ID, organizationIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при проверке прав пользователя")
+	}
+	responsible := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		responsible[id] = true
+	}
+	return responsible
+}
+
+// VisibleTenderLots возвращает лоты тех тендеров из tenderIDs, которые видит viewer
+func VisibleTenderLots(ctx context.Context, viewer *models.User, tenderIDs []string) (lots []models.Lot, err error) {
+	defer recoverError(&err)
+
+	tenders, err := VisibleTenders(ctx, viewer, tenderIDs)
+	if err != nil {
+		return nil, err
+	}
+	lots, err = database.GetLotsByTenderIDs(ctx, tenderResponseIDs(tenders))
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении лотов")
+	}
+	return lots, nil
+}
+
+// VisibleTenderBids возвращает страницу предложений по каждому из тех тендеров tenderIDs,
+// за организацию которых отвечает viewer, как ListTenderBids
+func VisibleTenderBids(ctx context.Context, viewer *models.User, tenderIDs []string, limit, offset int) (bids []models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	if viewer == nil {
+		return []models.BidResponse{}, nil
+	}
+	tenders, err := database.GetTendersByIDs(ctx, tenderIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении тендеров")
+	}
+	organizationIDs := make([]string, len(tenders))
+	for i, tender := range tenders {
+		organizationIDs[i] = tender.OrganizationID
+	}
+	responsible := responsibleOrganizations(ctx, viewer, organizationIDs)
+	managed := []string{}
+	for _, tender := range tenders {
+		if responsible[tender.OrganizationID] {
+			managed = append(managed, tender.ID)
+		}
+	}
+	bids, err = database.GetBidsByTenderIDs(ctx, managed, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	return bids, nil
+}
+
+// VisibleBids возвращает те предложения из bidIDs, которые доступны viewer по правилам checkBidAccess:
+// автору и ответственным за организацию тендера
+func VisibleBids(ctx context.Context, viewer *models.User, bidIDs []string) (visible []models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	visible = []models.BidResponse{}
+	if viewer == nil {
+		return visible, nil
+	}
+	bids, err := database.GetBidsByIDs(ctx, bidIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	managed := managedBids(ctx, viewer, bidIDs)
+	for _, bid := range bids {
+		if bid.AuthorID == viewer.ID || managed[bid.ID] {
+			visible = append(visible, bid)
+		}
+	}
+	return visible, nil
+}
+
+// VisibleBidLots возвращает цены по лотам тех предложений из bidIDs, которые доступны viewer
+func VisibleBidLots(ctx context.Context, viewer *models.User, bidIDs []string) (bidLots []models.BidLot, err error) {
+	defer recoverError(&err)
+
+	bids, err := VisibleBids(ctx, viewer, bidIDs)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(bids))
+	for i, bid := range bids {
+		ids[i] = bid.ID
+	}
+	bidLots, err = database.GetBidLotsByBidIDs(ctx, ids)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении лотов предложений")
+	}
+	return bidLots, nil
+}
+
+// VisibleBidDecisions возвращает решения по тем предложениям из bidIDs,
+// за организацию тендера которых отвечает viewer
+func VisibleBidDecisions(ctx context.Context, viewer *models.User, bidIDs []string) (decisions []models.UserDecision, err error) {
+	defer recoverError(&err)
+
+	decisions, err = database.GetDecisionsByBidIDs(ctx, managedBidIDs(ctx, viewer, bidIDs))
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении решений")
+	}
+	return decisions, nil
+}
+
+// VisibleBidFeedback возвращает отзывы на те предложения из bidIDs,
+// за организацию тендера которых отвечает viewer, как ListBidReviews
+func VisibleBidFeedback(ctx context.Context, viewer *models.User, bidIDs []string) (feedback []models.Feedback, err error) {
+	defer recoverError(&err)
+
+	feedback, err = database.GetFeedbackByBidIDs(ctx, managedBidIDs(ctx, viewer, bidIDs))
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении отзывов")
+	}
+	return feedback, nil
+}
+
+// ResponsibleOrganizationIDs возвращает те из organizationIDs, за которые отвечает viewer
+func ResponsibleOrganizationIDs(ctx context.Context, viewer *models.User, organizationIDs []string) (ids []string, err error) {
+	defer recoverError(&err)
+
+	if viewer == nil {
+		return []string{}, nil
+	}
+	ids, err = database.GetResponsibleOrganizationIDs(ctx, viewer.ID, organizationIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при проверке прав пользователя")
+	}
+	return ids, nil
+}
+
+// UsersByIDs возвращает пользователей по ID; имена пользователей открыты всем, как в ответах REST
+func UsersByIDs(ctx context.Context, userIDs []string) (users []models.User, err error) {
+	defer recoverError(&err)
+
+	users, err = database.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении пользователей")
+	}
+	return users, nil
+}
+
+// OrganizationsByIDs возвращает организации по ID; сведения об организациях открыты всем
+func OrganizationsByIDs(ctx context.Context, organizationIDs []string) (organizations []models.Organization, err error) {
+	defer recoverError(&err)
+
+	organizations, err = database.GetOrganizationsByIDs(ctx, organizationIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении организаций")
+	}
+	return organizations, nil
+}
+
+// managedBidIDs возвращает те из bidIDs, за организацию тендера которых отвечает viewer
+func managedBidIDs(ctx context.Context, viewer *models.User, bidIDs []string) []string {
+	if viewer == nil {
+		return []string{}
+	}
+	ids, err := database.GetManagedBidIDs(ctx, viewer.ID, bidIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при проверке прав пользователя")
+	}
+	return ids
+}
+
+func managedBids(ctx context.Context, viewer *models.User, bidIDs []string) map[string]bool {
+	managed := map[string]bool{}
+	for _, id := range managedBidIDs(ctx, viewer, bidIDs) {
+		managed[id] = true
+	}
+	return managed
+}
+
+func tenderResponseIDs(tenders []models.TenderResponse) []string {
+	ids := make([]string, len(tenders))
+	for i, tender := range tenders {
+		ids[i] = tender.ID
+	}
+	return ids
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"tender-service/internal/database"
+	"tender-service/internal/metrics"
+	"tender-service/internal/models"
+)
+
+func CreateBid(ctx context.Context, bidRequest *models.BidRequest) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateAuthorType(bidRequest.AuthorType)
+	validateID(bidRequest.AuthorID, "ID автора")
+	validateID(bidRequest.TenderID, "ID тендера")
+	validateName(bidRequest.Name, "предложения")
+	validateDescription(bidRequest.Description, "предложения")
+
+	tender := getAndValidateTenderByID(ctx, bidRequest.TenderID)
+	user := getAndValidateUserByID(ctx, bidRequest.AuthorID)
+
+	if bidRequest.AuthorType == models.AuthorTypeOrganization {
+		if !database.CheckUserOrganization(ctx, user.ID) {
+			fail(http.StatusForbidden, "Пользователь не связан с организацией")
+		}
+	}
+
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Тендер ещё не опубликован или закрыт")
+	}
+	checkBidDeadline(tender)
+	checkInvitationAccepted(ctx, tender, user)
+	if auction := getTenderAuction(ctx, tender.ID); auction != nil {
+		checkAuctionOpen(auction)
+	}
+	validateBidLots(bidRequest.Lots, getTenderLots(ctx, tender.ID))
+
+	bid := createBid(bidRequest)
+
+	if tender.Sealed {
+		createSealedBid(ctx, tender, bid, bidRequest)
+	} else {
+		if err := database.SaveBid(ctx, bid); err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при создании предложения")
+		}
+		if len(bidRequest.Lots) > 0 {
+			bidLots := make([]models.BidLot, len(bidRequest.Lots))
+			for i, lot := range bidRequest.Lots {
+				bidLots[i] = models.BidLot{BidID: bid.ID, LotID: lot.LotID, Price: lot.Price, Сoordination: models.Expectation}
+			}
+			if err := database.SaveBidLots(ctx, bidLots); err != nil {
+				fail(http.StatusInternalServerError, "Ошибка при сохранении лотов предложения")
+			}
+		}
+	}
+	metrics.BidSubmitted(bid.AuthorType)
+
+	return bidResponse(ctx, bid), nil
+}
+
+func ListUserBids(ctx context.Context, username string, limit, offset int) (bids []models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	limit, offset = validatePage(limit, offset)
+
+	bids, err = database.GetBidsByUserID(ctx, user.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	attachBidLots(ctx, bids)
+	revealBidResponses(ctx, bids)
+	return bids, nil
+}
+
+// ListTenderBids возвращает предложения по тендеру; доступно ответственным за организацию тендера.
+// Предложения по запечатанному тендеру до вскрытия возвращаются с заглушками вместо содержимого.
+func ListTenderBids(ctx context.Context, tenderID, username string, limit, offset int) (bids []models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для выполнения действия")
+	}
+	openIfDue(ctx, tender, user)
+	bids, err = database.GetBidsByTenderID(ctx, tenderID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	attachBidLots(ctx, bids)
+	attachReputations(ctx, bids)
+	return bids, nil
+}
+
+// approvalQuorum — число одобрений, при котором предложение (или его участие в лоте) принимается
+const approvalQuorum = 3
+
+// SubmitBidDecision сохраняет решение ответственного по предложению. Отказ сразу закрывает предложение,
+// а набранный кворум одобрений закрывает его как принятое, создаёт договор и отклоняет остальные предложения по тендеру.
+// Решение относится к текущей версии предложения, и в кворуме учитываются только одобрения этой версии.
+// Для тендера с лотами решение принимается по лоту lotID (см. submitLotDecision). Комментарий необязателен.
+func SubmitBidDecision(ctx context.Context, bidID string, decision models.Сoordination, username, lotID, comment string) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateDecision(decision)
+	validateUsername(username)
+	validateDecisionComment(comment)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "пользователь не имеет ответственности к организации стенда")
+	}
+	if checkBidsOpened(ctx, tender, user) {
+		bid = getAndValidateBidByID(ctx, bid.ID)
+	}
+	if bid.Status != models.Published {
+		fail(http.StatusForbidden, "Предложение не может быть обработано, так как его статус находится в состоянии 'Создание' или 'Закрыто'. Решение может быть отправлено только для предложений в статусе 'Публичное'.")
+	}
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Тендер не может быть обработан, так как он находится в статусе 'Создание' или 'Закрыт'. Для отправление решении тендер должен находиться в статусе 'Публичный'.")
+	}
+	if getTenderAuction(ctx, tender.ID) != nil {
+		fail(http.StatusForbidden, "Победитель торгов определяется автоматически по наименьшей цене")
+	}
+
+	if lots := getTenderLots(ctx, tender.ID); len(lots) > 0 {
+		submitLotDecision(ctx, user, bid, tender, lots, decision, lotID, comment)
+		return bidResponse(ctx, bid), nil
+	}
+	if lotID != "" {
+		fail(http.StatusBadRequest, "У тендера нет лотов, решение принимается по предложению целиком")
+	}
+
+	userDecision := &models.UserDecision{
+		UserID:     user.ID,
+		BidID:      bid.ID,
+		BidVersion: bid.Version,
+		Decision:   decision,
+		Comment:    comment,
+	}
+
+	if database.CheckUserDecisionExists(ctx, userDecision) {
+		fail(http.StatusForbidden, "пользователь раннее давал свое решение")
+	}
+
+	saveUserDecision(ctx, userDecision)
+	metrics.DecisionSubmitted(decision)
+
+	userDecisions, _ := database.GetApprovedDecisionsByBidID(ctx, bid.ID, "", bid.Version)
+
+	var conflicting []models.Bid
+	if decision == models.Rejected {
+		bid.Сoordination = models.Rejected
+		bid.Status = models.Closed
+	} else if len(userDecisions) >= approvalQuorum {
+		bid.Сoordination = models.Approved
+		bid.Status = models.Closed
+		bids, err := database.GetBidsByTenderIDWithExpectation(ctx, tender.ID)
+		if err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+		}
+		for _, Bid := range bids {
+			// Одобренное предложение ещё не сохранено и тоже ожидает решения
+			if Bid.ID == bid.ID {
+				continue
+			}
+			Bid.Status = models.Closed
+			Bid.Сoordination = models.RejectedByConflict
+			updateBid(ctx, &Bid, Bid.Version, "Ошибка при обновлении статуса предложения")
+			conflicting = append(conflicting, Bid)
+		}
+	}
+
+	updateBid(ctx, bid, bid.Version, "Ошибка при обновлении статуса предложения")
+	if bid.Сoordination != models.Expectation {
+		metrics.BidCoordinated(bid.Сoordination)
+		publishBid(bid, tender)
+	}
+	if bid.Сoordination == models.Approved {
+		createAward(ctx, bid, "", nil)
+		metrics.TenderAwarded(tender.CreatedAt)
+	}
+	// Решения учитываются в метриках, только когда все предложения сохранены:
+	// одобренное — один раз как Approved, остальные — как RejectedByConflict
+	for i := range conflicting {
+		metrics.BidCoordinated(conflicting[i].Сoordination)
+		publishBid(&conflicting[i], tender)
+	}
+
+	return bidResponse(ctx, bid), nil
+}
+
+// GetBidStatus возвращает статус предложения и его текущую версию
+func GetBidStatus(ctx context.Context, bidID, username string) (status models.Status, version int, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	bid := getAndValidateBidByID(ctx, bidID)
+
+	return bid.Status, bid.Version, nil
+}
+
+// UpdateBidStatus меняет статус предложения; expectedVersion (0 — без проверки) должна совпадать с текущей версией
+func UpdateBidStatus(ctx context.Context, bidID string, newStatus models.Status, username string, expectedVersion int) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateBidStatus(newStatus)
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	checkExpectedVersion(expectedVersion, bid.Version)
+	if bid.Status == models.Canceled && getOpenBidWithdrawal(ctx, bid.ID) != nil {
+		fail(http.StatusConflict, "Предложение отозвано, вернуть его можно только повторной подачей")
+	}
+
+	bid.Status = newStatus
+
+	updateBid(ctx, bid, bid.Version, "Ошибка при обновлении статуса тендера")
+	if tender, err := database.GetTenderByID(ctx, bid.TenderID); err == nil {
+		publishBid(bid, tender)
+	}
+
+	return bidResponse(ctx, bid), nil
+}
+
+// EditBid изменяет предложение и сохраняет прежнюю версию в историю
+func EditBid(ctx context.Context, bidID, username string, bidEditRequest *models.BidEditRequest, expectedVersion int) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	bid := getAndValidateBidByID(ctx, bidID)
+	user := getAndValidateUserByUsername(ctx, username)
+
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	checkExpectedVersion(expectedVersion, bid.Version)
+
+	if bidEditRequest.Name == "" && bidEditRequest.Description == "" {
+		fail(http.StatusBadRequest, "Отправлен пустой запрос")
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	checkSealedBidEditable(tender)
+	checkEditAllowed(ctx, bid)
+
+	copybid := *bid
+	if bid.SealedPayload != nil {
+		editSealedBid(tender, bid, bidEditRequest)
+	} else {
+		updateBidFields(bidEditRequest, bid)
+	}
+	bid.Version++
+	updateBid(ctx, bid, copybid.Version, "Ошибка при обновлении тендера")
+	saveBidHistory(ctx, &copybid)
+	return bidResponse(ctx, bid), nil
+}
+
+func RollbackBid(ctx context.Context, bidID string, version int, username string, expectedVersion int) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID тендера")
+	validateUsername(username)
+
+	bid := getAndValidateBidByID(ctx, bidID)
+	user := getAndValidateUserByUsername(ctx, username)
+
+	validateVersion(version, bid.Version)
+
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	checkExpectedVersion(expectedVersion, bid.Version)
+
+	checkSealedBidEditable(getAndValidateTenderByID(ctx, bid.TenderID))
+	checkEditAllowed(ctx, bid)
+
+	bidHistory := getAndValidateBidHistoryVersion(ctx, bid.ID, version)
+
+	copybid := *bid
+
+	bid.Name = bidHistory.Name
+	bid.Description = bidHistory.Description
+	bid.SealedPayload = bidHistory.SealedPayload
+	bid.Version++
+
+	updateBid(ctx, bid, copybid.Version, "Ошибка при обновлении тендера")
+	saveBidHistory(ctx, &copybid)
+	return bidResponse(ctx, bid), nil
+}
+
+// SubmitBidFeedback сохраняет отзыв ответственного на предложение. Оценки необязательны, но если они есть,
+// то нужны все три; оценить предложение можно один раз, и оценки учитываются в репутации автора.
+func SubmitBidFeedback(ctx context.Context, bidID, username string, request *models.FeedbackRequest) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	validateFeedback(request.Description)
+	validateRatings(request.Ratings)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	teder := getAndValidateTenderByID(ctx, bid.TenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, teder.OrganizationID) {
+		fail(http.StatusForbidden, "пользователь не имеет ответственности к организации тендера")
+	}
+	if checkBidsOpened(ctx, teder, user) {
+		bid = getAndValidateBidByID(ctx, bid.ID)
+	}
+
+	if bid.Status == models.Closed {
+		fail(http.StatusForbidden, "Отзыв может быть отправлен только на опубликованное или закрытое предложение")
+	}
+
+	feedback := &models.Feedback{
+		UserID:      user.ID,
+		BidID:       bid.ID,
+		BidFeedback: request.Description,
+		Ratings:     request.Ratings,
+	}
+
+	if err := database.SaveFeedback(ctx, feedback); err != nil {
+		if errors.Is(err, database.ErrFeedbackRated) {
+			fail(http.StatusConflict, "Пользователь уже оценил это предложение")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении отзыва")
+	}
+	return bidResponse(ctx, bid), nil
+}
+
+// ListBidReviews возвращает отзывы на предложение автора authorUsername по тендеру вместе с ответами автора;
+// скрытые модератором отзывы и ответы не возвращаются.
+// Запрашивающий должен отвечать за организацию тендера.
+func ListBidReviews(ctx context.Context, tenderID, authorUsername, requesterUsername string, limit, offset int) (reviews []models.FeedbackResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(authorUsername)
+	validateUsername(requesterUsername)
+
+	authorUser := getAndValidateUserByUsername(ctx, authorUsername)
+	requesterUser := getAndValidateUserByUsername(ctx, requesterUsername)
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	bid := getAndValidateBidByTenderAndAuthorID(ctx, tender.ID, authorUser.ID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, requesterUser.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для просмотра отзывов на предложения")
+	}
+
+	limit, offset = validatePage(limit, offset)
+
+	reviews, err = database.GetBidReviews(ctx, bid.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении отзывов")
+	}
+	attachFeedbackReplies(ctx, reviews)
+	return reviews, nil
+}
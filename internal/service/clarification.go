@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// AskClarification публикует вопрос по тендеру. Спрашивать могут участники, то есть любые пользователи,
+// кроме ответственных за организацию тендера, пока тендер опубликован и срок подачи предложений не истёк.
+// В тендере по приглашениям участники — только принявшие приглашение.
+func AskClarification(ctx context.Context, tenderID, username string, request *models.ClarificationRequest) (resp *models.ClarificationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	validateClarificationText(request.Question, "Вопрос")
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Тендер ещё не опубликован или закрыт")
+	}
+	if database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Ответственные за организацию тендера не могут задавать вопросы по нему")
+	}
+	checkInvitationAccepted(ctx, tender, user)
+	checkBidDeadline(tender)
+
+	clarification := &models.Clarification{TenderID: tender.ID, AuthorID: user.ID, Question: request.Question}
+	if err := database.SaveClarification(ctx, clarification); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении вопроса")
+	}
+	return createClarificationResponse(clarification, user.ID), nil
+}
+
+// ListClarifications возвращает вопросы по тендеру. Ответственные за организацию видят все вопросы,
+// остальные — вопросы с ответами и свои собственные. Автор вопроса в ответе не раскрывается.
+func ListClarifications(ctx context.Context, tenderID, username string, limit, offset int) (clarifications []models.ClarificationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	limit, offset = validatePage(limit, offset)
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра вопросов по тендеру")
+
+	var viewerID string
+	responsible := false
+	if username != "" {
+		user := getAndValidateUserByUsername(ctx, username)
+		viewerID = user.ID
+		responsible = database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID)
+	}
+
+	list, err := database.GetClarifications(ctx, tender.ID, responsible, viewerID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении вопросов по тендеру")
+	}
+	clarifications = make([]models.ClarificationResponse, 0, len(list))
+	for i := range list {
+		clarifications = append(clarifications, *createClarificationResponse(&list[i], viewerID))
+	}
+	return clarifications, nil
+}
+
+// AnswerClarification сохраняет ответ на вопрос; после этого вопрос и ответ видны всем участникам.
+// Отвечают ответственные за организацию тендера, повторный ответ заменяет прежний.
+func AnswerClarification(ctx context.Context, tenderID, clarificationID, username string, request *models.ClarificationAnswerRequest) (resp *models.ClarificationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateID(clarificationID, "ID вопроса")
+	validateUsername(username)
+	validateClarificationText(request.Answer, "Ответ")
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для ответа на вопрос")
+	}
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Тендер не опубликован")
+	}
+
+	clarification := getAndValidateClarification(ctx, clarificationID, tender.ID)
+	clarification.Answer = request.Answer
+	clarification.AnsweredBy = user.ID
+	if err := database.AnswerClarification(ctx, clarification); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении ответа")
+	}
+	return createClarificationResponse(clarification, user.ID), nil
+}
+
+func getAndValidateClarification(ctx context.Context, clarificationID, tenderID string) *models.Clarification {
+	clarification, err := database.GetClarificationByID(ctx, clarificationID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, fmt.Sprintf("Вопрос с ID %s не найден", clarificationID))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении вопроса")
+	}
+	if clarification.TenderID != tenderID {
+		fail(http.StatusNotFound, fmt.Sprintf("Вопрос с ID %s не найден", clarificationID))
+	}
+	return clarification
+}
+
+func createClarificationResponse(clarification *models.Clarification, viewerID string) *models.ClarificationResponse {
+	resp := &models.ClarificationResponse{
+		ID:        clarification.ID,
+		Question:  clarification.Question,
+		Answer:    clarification.Answer,
+		Mine:      viewerID != "" && clarification.AuthorID == viewerID,
+		CreatedAt: clarification.CreatedAt.Format(time.RFC3339),
+	}
+	if clarification.AnsweredAt != nil {
+		resp.AnsweredAt = clarification.AnsweredAt.Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// EditPolicy определяет, можно ли менять опубликованное предложение, по которому уже есть решения
+type EditPolicy string
+
+const (
+	// EditAllow разрешает правку; решения по прежней версии перестают учитываться в кворуме
+	EditAllow EditPolicy = "allow"
+	// EditLock запрещает правку и откат, пока по текущей версии есть решения
+	EditLock EditPolicy = "lock"
+)
+
+var editPolicy = EditAllow
+
+// SetupEditPolicy задаёт политику правки предложений, ожидающих решения
+func SetupEditPolicy(policy EditPolicy) {
+	editPolicy = policy
+}
+
+// checkEditAllowed применяет политику правки к предложению, которое автор собирается изменить или откатить.
+// Отозванное предложение на рассмотрении не находится, поэтому его можно менять при любой политике.
+func checkEditAllowed(ctx context.Context, bid *models.Bid) {
+	if editPolicy != EditLock || bid.Status != models.Published || bid.Сoordination != models.Expectation {
+		return
+	}
+	decided, err := database.HasBidDecisions(ctx, bid.ID, bid.Version)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении решений")
+	}
+	if decided {
+		fail(http.StatusConflict, "По предложению уже есть решения, изменить его до окончательного решения нельзя")
+	}
+}
+
+// saveUserDecision сохраняет решение, если с момента чтения предложения его версия не изменилась
+func saveUserDecision(ctx context.Context, userDecision *models.UserDecision) {
+	if err := database.SaveUserDecision(ctx, userDecision); err != nil {
+		if errors.Is(err, database.ErrVersionConflict) {
+			fail(http.StatusPreconditionFailed, "Предложение было изменено, пока принималось решение, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении решения")
+	}
+}
+
+// RevokeBidDecision отзывает решение пользователя по текущей версии предложения, пока по предложению
+// (или лоту lotID для тендера с лотами) нет окончательного решения. После отзыва решение не учитывается
+// в кворуме, и пользователь может принять новое.
+func RevokeBidDecision(ctx context.Context, bidID, username, lotID string) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для отзыва решения")
+	}
+	if lots := getTenderLots(ctx, tender.ID); len(lots) > 0 {
+		lotID = resolveDecisionLot(getBidLots(ctx, bid.ID), lotID)
+	} else if lotID != "" {
+		fail(http.StatusBadRequest, "У тендера нет лотов, решение принимается по предложению целиком")
+	}
+	if bid.Status != models.Published || bid.Сoordination != models.Expectation {
+		fail(http.StatusForbidden, "По предложению уже принято окончательное решение")
+	}
+
+	userDecision := &models.UserDecision{
+		UserID:     user.ID,
+		BidID:      bid.ID,
+		LotID:      lotID,
+		BidVersion: bid.Version,
+	}
+	if err := database.RevokeUserDecision(ctx, userDecision); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			fail(http.StatusNotFound, "Пользователь не принимал решение по текущей версии предложения")
+		case errors.Is(err, database.ErrVersionConflict):
+			fail(http.StatusConflict, "Предложение изменено или по нему уже принято решение, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при отзыве решения")
+	}
+	return bidResponse(ctx, bid), nil
+}
+
+// ListBidDecisions возвращает историю решений по предложению от последнего к первому, включая отозванные.
+// Ответственные за организацию тендера видят все решения и их авторов, автор предложения —
+// только решения с комментарием, без указания, кто их принял.
+func ListBidDecisions(ctx context.Context, bidID, username string, limit, offset int) (decisions []models.BidDecisionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	responsible := database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID)
+	if !responsible && user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "Недостаточно прав для просмотра решений по предложению")
+	}
+
+	stored, err := database.GetBidDecisions(ctx, bid.ID, !responsible, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении решений")
+	}
+	decisions = make([]models.BidDecisionResponse, len(stored))
+	for i := range stored {
+		decisions[i] = createBidDecisionResponse(&stored[i])
+		if !responsible {
+			decisions[i].Author = ""
+		}
+	}
+	return decisions, nil
+}
+
+func createBidDecisionResponse(decision *models.UserDecision) models.BidDecisionResponse {
+	return models.BidDecisionResponse{
+		ID:            decision.ID,
+		BidID:         decision.BidID,
+		LotID:         decision.LotID,
+		BidVersion:    decision.BidVersion,
+		Author:        decision.Username,
+		Decision:      decision.Decision,
+		Comment:       decision.Comment,
+		CreatedAt:     decision.Created_at.Format(time.RFC3339),
+		RevokedAt:     models.FormatTime(decision.RevokedAt),
+		InvalidatedAt: models.FormatTime(decision.InvalidatedAt),
+	}
+}
@@ -0,0 +1,39 @@
+// Package service содержит бизнес-операции над тендерами и предложениями.
+// Через него работают и REST-обработчики, и gRPC-сервер: проверка входных данных,
+// прав пользователя и переходов статусов выполняется только здесь.
+package service
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Error — ожидаемая ошибка операции: HTTP-код и причина, которую можно показать клиенту
+type Error struct {
+	Code   int
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Code, http.StatusText(e.Code), e.Reason)
+}
+
+// fail прерывает операцию ошибкой. Паника перехватывается recoverError
+// в экспортируемой функции, которая возвращает её как обычную ошибку.
+func fail(code int, reason string) {
+	panic(&Error{Code: code, Reason: reason})
+}
+
+// recoverError превращает панику, вызванную fail, в возвращаемую ошибку.
+// Прочие паники пробрасываются дальше.
+func recoverError(err *error) {
+	rec := recover()
+	if rec == nil {
+		return
+	}
+	if serr, ok := rec.(*Error); ok {
+		*err = serr
+		return
+	}
+	panic(rec)
+}
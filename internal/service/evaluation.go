@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+const (
+	// maxCriteria ограничивает число критериев оценки одного тендера
+	maxCriteria = 20
+	// maxCriterionWeight — наибольший допустимый вес критерия
+	maxCriterionWeight = 1000
+	// maxScore — наибольшая оценка по критерию; наименьшая — 0
+	maxScore = 10
+)
+
+// SetEvaluationCriteria заменяет критерии оценки тендера. Менять критерии могут ответственные
+// за организацию тендера, пока тендер не закрыт и ни одно предложение не оценено.
+func SetEvaluationCriteria(ctx context.Context, tenderID, username string, requests []models.CriterionRequest) (criteria []models.CriterionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	validateCriteria(requests)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для изменения критериев оценки")
+	}
+	if tender.Status == models.Closed {
+		fail(http.StatusForbidden, "Тендер закрыт, критерии оценки изменить нельзя")
+	}
+	scored, err := database.HasBidScores(ctx, tender.ID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при проверке оценок предложений")
+	}
+	if scored {
+		fail(http.StatusConflict, "Предложения по тендеру уже оцениваются, критерии изменить нельзя")
+	}
+
+	list := make([]models.Criterion, len(requests))
+	for i, request := range requests {
+		list[i] = models.Criterion{Name: request.Name, Description: request.Description, Weight: request.Weight}
+	}
+	if err := database.ReplaceCriteria(ctx, tender.ID, list); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении критериев оценки")
+	}
+	return createCriterionResponses(list), nil
+}
+
+// ListEvaluationCriteria возвращает критерии оценки тендера. Критерии опубликованного тендера видны всем,
+// чтобы участники знали, как будут оцениваться предложения.
+func ListEvaluationCriteria(ctx context.Context, tenderID, username string) (criteria []models.CriterionResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра критериев оценки")
+
+	return createCriterionResponses(getTenderCriteria(ctx, tender.ID)), nil
+}
+
+// SubmitBidScores сохраняет оценки предложения от ответственного за организацию тендера.
+// Оценки выставляются сразу по всем критериям; повторная отправка заменяет прежние оценки этого ответственного.
+func SubmitBidScores(ctx context.Context, bidID, username string, request *models.BidScoresRequest) (resp *models.BidEvaluationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для оценки предложения")
+	}
+	if checkBidsOpened(ctx, tender, user) {
+		bid = getAndValidateBidByID(ctx, bid.ID)
+	}
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Оценивать предложения можно только по опубликованному тендеру")
+	}
+	if bid.Status != models.Published {
+		fail(http.StatusForbidden, "Оценивать можно только опубликованные предложения")
+	}
+
+	criteria := getTenderCriteria(ctx, tender.ID)
+	if len(criteria) == 0 {
+		fail(http.StatusConflict, "У тендера нет критериев оценки")
+	}
+	validateBidScores(request.Scores, criteria)
+
+	scores := make([]models.BidScore, len(request.Scores))
+	for i, score := range request.Scores {
+		scores[i] = models.BidScore{BidID: bid.ID, CriterionID: score.CriterionID, EvaluatorID: user.ID, Score: score.Score}
+	}
+	if err := database.SaveBidScores(ctx, scores); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении оценок")
+	}
+
+	resp = &models.BidEvaluationResponse{BidID: bid.ID, Scores: make([]models.BidScoreResponse, len(scores))}
+	for i, score := range scores {
+		resp.Scores[i] = models.BidScoreResponse{CriterionID: score.CriterionID, Score: score.Score}
+	}
+	return resp, nil
+}
+
+// GetBidRanking возвращает таблицу предложений по тендеру, упорядоченную по взвешенной оценке.
+// Оценка предложения по критерию — среднее оценок всех оценивших, итог — среднее по критериям
+// с учётом весов. Неоценённые предложения идут в конце без места и итоговой оценки.
+func GetBidRanking(ctx context.Context, tenderID, username string) (ranking []models.RankingEntry, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для просмотра рейтинга предложений")
+	}
+	checkBidsOpened(ctx, tender, user)
+
+	criteria := getTenderCriteria(ctx, tender.ID)
+	bids, err := database.GetRankedBids(ctx, tender.ID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	scores, err := database.GetBidScoresByTenderID(ctx, tender.ID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении оценок")
+	}
+	return rankBids(bids, criteria, scores), nil
+}
+
+func validateCriteria(criteria []models.CriterionRequest) {
+	if len(criteria) == 0 {
+		fail(http.StatusBadRequest, "Укажите хотя бы один критерий оценки")
+	}
+	if len(criteria) > maxCriteria {
+		fail(http.StatusBadRequest, fmt.Sprintf("Слишком много критериев оценки, максимум %d", maxCriteria))
+	}
+	for i, criterion := range criteria {
+		field := fmt.Sprintf("критерия %d", i+1)
+		validateName(criterion.Name, field)
+		if len(criterion.Description) > 500 {
+			fail(http.StatusBadRequest, fmt.Sprintf("Описание %s слишком длинное, максимум 500 символов", field))
+		}
+		if criterion.Weight <= 0 || criterion.Weight > maxCriterionWeight {
+			fail(http.StatusBadRequest, fmt.Sprintf("Вес %s должен быть больше нуля и не больше %d", field, maxCriterionWeight))
+		}
+	}
+}
+
+// validateBidScores проверяет, что оценки выставлены ровно по одному разу по каждому критерию тендера
+func validateBidScores(scores []models.BidScoreRequest, criteria []models.Criterion) {
+	known := make(map[string]bool, len(criteria))
+	for _, criterion := range criteria {
+		known[criterion.ID] = true
+	}
+	seen := map[string]bool{}
+	for _, score := range scores {
+		validateID(score.CriterionID, "ID критерия")
+		if !known[score.CriterionID] {
+			fail(http.StatusBadRequest, fmt.Sprintf("Критерий %s не относится к тендеру", score.CriterionID))
+		}
+		if seen[score.CriterionID] {
+			fail(http.StatusBadRequest, fmt.Sprintf("Критерий %s указан несколько раз", score.CriterionID))
+		}
+		seen[score.CriterionID] = true
+		if score.Score < 0 || score.Score > maxScore {
+			fail(http.StatusBadRequest, fmt.Sprintf("Оценка должна быть от 0 до %d", maxScore))
+		}
+	}
+	if len(seen) != len(criteria) {
+		fail(http.StatusBadRequest, "Предложение оценивается сразу по всем критериям тендера")
+	}
+}
+
+// rankBids считает средние оценки по критериям и взвешенный итог и упорядочивает предложения.
+// Предложения с равным итогом делят место, следующее место пропускается (1, 1, 3).
+func rankBids(bids []models.Bid, criteria []models.Criterion, scores []models.BidScore) []models.RankingEntry {
+	type sum struct {
+		total float64
+		count int
+	}
+	sums := map[string]map[string]*sum{}
+	evaluators := map[string]map[string]bool{}
+	for _, score := range scores {
+		if sums[score.BidID] == nil {
+			sums[score.BidID] = map[string]*sum{}
+			evaluators[score.BidID] = map[string]bool{}
+		}
+		s := sums[score.BidID][score.CriterionID]
+		if s == nil {
+			s = &sum{}
+			sums[score.BidID][score.CriterionID] = s
+		}
+		s.total += float64(score.Score)
+		s.count++
+		evaluators[score.BidID][score.EvaluatorID] = true
+	}
+
+	var totalWeight float64
+	for _, criterion := range criteria {
+		totalWeight += criterion.Weight
+	}
+
+	ranking := make([]models.RankingEntry, 0, len(bids))
+	for _, bid := range bids {
+		entry := models.RankingEntry{
+			BidID:        bid.ID,
+			BidName:      bid.Name,
+			AuthorType:   bid.AuthorType,
+			AuthorID:     bid.AuthorID,
+			Status:       bid.Status,
+			Coordination: bid.Сoordination,
+			Evaluators:   len(evaluators[bid.ID]),
+			Criteria:     make([]models.CriterionAverage, 0, len(criteria)),
+		}
+		var weighted float64
+		for _, criterion := range criteria {
+			var average float64
+			if s := sums[bid.ID][criterion.ID]; s != nil {
+				average = s.total / float64(s.count)
+			}
+			entry.Criteria = append(entry.Criteria, models.CriterionAverage{CriterionID: criterion.ID, Average: roundScore(average)})
+			weighted += criterion.Weight * average
+		}
+		if entry.Evaluators > 0 && totalWeight > 0 {
+			score := roundScore(weighted / totalWeight)
+			entry.Score = &score
+		}
+		ranking = append(ranking, entry)
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		a, b := ranking[i].Score, ranking[j].Score
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a > *b
+	})
+	for i := range ranking {
+		if ranking[i].Score == nil {
+			break
+		}
+		if i > 0 && *ranking[i].Score == *ranking[i-1].Score {
+			ranking[i].Rank = ranking[i-1].Rank
+		} else {
+			ranking[i].Rank = i + 1
+		}
+	}
+	return ranking
+}
+
+// roundScore округляет оценку до сотых, чтобы равные по смыслу итоги не различались из-за погрешности
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+func getTenderCriteria(ctx context.Context, tenderID string) []models.Criterion {
+	criteria, err := database.GetCriteria(ctx, tenderID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении критериев оценки")
+	}
+	return criteria
+}
+
+func createCriterionResponses(criteria []models.Criterion) []models.CriterionResponse {
+	resp := make([]models.CriterionResponse, len(criteria))
+	for i, criterion := range criteria {
+		resp[i] = models.CriterionResponse{
+			ID:          criterion.ID,
+			Name:        criterion.Name,
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+		}
+	}
+	return resp
+}
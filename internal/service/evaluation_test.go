@@ -0,0 +1,207 @@
+package service
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"tender-service/internal/models"
+)
+
+const (
+	priceID   = "11111111-1111-1111-1111-111111111111"
+	qualityID = "22222222-2222-2222-2222-222222222222"
+	unknownID = "33333333-3333-3333-3333-333333333333"
+)
+
+var testCriteria = []models.Criterion{
+	{ID: priceID, Name: "Цена", Weight: 3},
+	{ID: qualityID, Name: "Качество", Weight: 1},
+}
+
+func scoresFor(bidID, evaluatorID string, price, quality int) []models.BidScore {
+	return []models.BidScore{
+		{BidID: bidID, CriterionID: priceID, EvaluatorID: evaluatorID, Score: price},
+		{BidID: bidID, CriterionID: qualityID, EvaluatorID: evaluatorID, Score: quality},
+	}
+}
+
+func concatScores(groups ...[]models.BidScore) []models.BidScore {
+	var all []models.BidScore
+	for _, group := range groups {
+		all = append(all, group...)
+	}
+	return all
+}
+
+// rankingRow — сокращённая строка рейтинга для сравнения в тестах; score -1 — предложение не оценено
+type rankingRow struct {
+	bidID string
+	rank  int
+	score float64
+}
+
+func TestRankBids(t *testing.T) {
+	bids := []models.Bid{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}, {ID: "c", Name: "C"}}
+
+	tests := []struct {
+		name     string
+		bids     []models.Bid
+		criteria []models.Criterion
+		scores   []models.BidScore
+		want     []rankingRow
+	}{
+		{
+			name:     "взвешенный итог определяет порядок",
+			bids:     bids,
+			criteria: testCriteria,
+			scores: concatScores(
+				scoresFor("a", "u1", 4, 10), // (3*4 + 1*10) / 4 = 5.5
+				scoresFor("b", "u1", 8, 0),  // (3*8 + 0) / 4 = 6
+				scoresFor("c", "u1", 2, 2),  // 2
+			),
+			want: []rankingRow{{"b", 1, 6}, {"a", 2, 5.5}, {"c", 3, 2}},
+		},
+		{
+			name:     "оценки нескольких ответственных усредняются",
+			bids:     bids[:1],
+			criteria: testCriteria,
+			scores: concatScores(
+				scoresFor("a", "u1", 10, 4),
+				scoresFor("a", "u2", 5, 8),
+			), // цена 7.5, качество 6: (22.5 + 6) / 4 = 7.125
+			want: []rankingRow{{"a", 1, 7.13}},
+		},
+		{
+			name:     "равные итоги делят место, следующее пропускается",
+			bids:     bids,
+			criteria: testCriteria,
+			scores: concatScores(
+				scoresFor("a", "u1", 5, 5),
+				scoresFor("b", "u1", 9, 9),
+				scoresFor("c", "u1", 5, 5),
+			),
+			want: []rankingRow{{"b", 1, 9}, {"a", 2, 5}, {"c", 2, 5}},
+		},
+		{
+			name:     "неоценённые предложения в конце без места",
+			bids:     bids,
+			criteria: testCriteria,
+			scores:   scoresFor("b", "u1", 0, 0),
+			want:     []rankingRow{{"b", 1, 0}, {"a", 0, -1}, {"c", 0, -1}},
+		},
+		{
+			name:     "без критериев итог не считается",
+			bids:     bids[:2],
+			criteria: nil,
+			scores:   scoresFor("a", "u1", 5, 5),
+			want:     []rankingRow{{"a", 0, -1}, {"b", 0, -1}},
+		},
+		{
+			name:     "погрешность не разделяет равные итоги",
+			bids:     bids[:2],
+			criteria: []models.Criterion{{ID: priceID, Weight: 0.1}, {ID: qualityID, Weight: 0.2}},
+			scores: concatScores(
+				scoresFor("a", "u1", 3, 3),
+				scoresFor("b", "u1", 3, 3),
+			),
+			want: []rankingRow{{"a", 1, 3}, {"b", 1, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranking := rankBids(tt.bids, tt.criteria, tt.scores)
+			got := make([]rankingRow, len(ranking))
+			for i, entry := range ranking {
+				got[i] = rankingRow{bidID: entry.BidID, rank: entry.Rank, score: -1}
+				if entry.Score != nil {
+					got[i].score = *entry.Score
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rankBids() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankBidsCriteriaAverages(t *testing.T) {
+	scores := concatScores(
+		scoresFor("a", "u1", 1, 10),
+		scoresFor("a", "u2", 2, 10),
+		scoresFor("a", "u3", 2, 9),
+	)
+	ranking := rankBids([]models.Bid{{ID: "a"}}, testCriteria, scores)
+
+	entry := ranking[0]
+	if entry.Evaluators != 3 {
+		t.Errorf("Evaluators = %d, want 3", entry.Evaluators)
+	}
+	want := []models.CriterionAverage{
+		{CriterionID: priceID, Average: 1.67},
+		{CriterionID: qualityID, Average: 9.67},
+	}
+	if !reflect.DeepEqual(entry.Criteria, want) {
+		t.Errorf("Criteria = %+v, want %+v", entry.Criteria, want)
+	}
+}
+
+func TestValidateBidScores(t *testing.T) {
+	tests := []struct {
+		name    string
+		scores  []models.BidScoreRequest
+		wantErr string
+	}{
+		{
+			name:   "все критерии по одному разу",
+			scores: []models.BidScoreRequest{{CriterionID: priceID, Score: 0}, {CriterionID: qualityID, Score: maxScore}},
+		},
+		{
+			name:    "пропущен критерий",
+			scores:  []models.BidScoreRequest{{CriterionID: priceID, Score: 5}},
+			wantErr: "сразу по всем критериям",
+		},
+		{
+			name:    "критерий указан дважды",
+			scores:  []models.BidScoreRequest{{CriterionID: priceID, Score: 5}, {CriterionID: priceID, Score: 6}},
+			wantErr: "указан несколько раз",
+		},
+		{
+			name:    "чужой критерий",
+			scores:  []models.BidScoreRequest{{CriterionID: priceID, Score: 5}, {CriterionID: unknownID, Score: 5}},
+			wantErr: "не относится к тендеру",
+		},
+		{
+			name:    "оценка выше максимума",
+			scores:  []models.BidScoreRequest{{CriterionID: priceID, Score: maxScore + 1}, {CriterionID: qualityID, Score: 5}},
+			wantErr: "Оценка должна быть от 0",
+		},
+		{
+			name:    "отрицательная оценка",
+			scores:  []models.BidScoreRequest{{CriterionID: priceID, Score: -1}, {CriterionID: qualityID, Score: 5}},
+			wantErr: "Оценка должна быть от 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := func() (err error) {
+				defer recoverError(&err)
+				validateBidScores(tt.scores, testCriteria)
+				return nil
+			}()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateBidScores() error = %v", err)
+				}
+				return
+			}
+			serr, ok := err.(*Error)
+			if !ok || serr.Code != http.StatusBadRequest || !strings.Contains(serr.Reason, tt.wantErr) {
+				t.Errorf("validateBidScores() error = %v, want 400 containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
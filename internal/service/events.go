@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+type EntityKind string
+
+const (
+	KindTender EntityKind = "Tender"
+	KindBid    EntityKind = "Bid"
+)
+
+// StatusChange — событие изменения статуса тендера или предложения.
+// Для тендера TenderID совпадает с ID, AuthorID пуст.
+type StatusChange struct {
+	Kind           EntityKind
+	ID             string
+	TenderID       string
+	OrganizationID string // организация тендера
+	AuthorID       string // автор предложения
+	Status         models.Status
+	Coordination   models.Сoordination // только для предложений
+	Version        int
+	Reason         string                  // причина отзыва или повторной подачи предложения
+	Visibility     models.TenderVisibility // видимость тендера; только для тендеров
+	ChangedAt      time.Time
+}
+
+// subscriberBuffer — сколько событий может накопить подписчик, прежде чем его отключат
+const subscriberBuffer = 64
+
+// Subscription получает события изменения статусов. События рассылаются внутри процесса:
+// подписчик видит только изменения, сделанные этим экземпляром сервиса.
+type Subscription struct {
+	C <-chan StatusChange
+
+	ch     chan StatusChange
+	lagged bool
+}
+
+var (
+	subscribersMu sync.Mutex
+	subscribers   = map[*Subscription]struct{}{}
+	closed        bool
+)
+
+// Subscribe регистрирует нового подписчика. Вызывающий обязан вызвать Unsubscribe.
+// После CloseSubscriptions возвращается подписка с уже закрытым каналом.
+func Subscribe() *Subscription {
+	ch := make(chan StatusChange, subscriberBuffer)
+	sub := &Subscription{C: ch, ch: ch}
+
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	if closed {
+		close(ch)
+		return sub
+	}
+	subscribers[sub] = struct{}{}
+	return sub
+}
+
+// CloseSubscriptions закрывает каналы всех подписчиков и запрещает новые подписки.
+// Вызывается при остановке сервиса.
+func CloseSubscriptions() {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	closed = true
+	for sub := range subscribers {
+		delete(subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// Unsubscribe отключает подписчика и закрывает его канал. Повторный вызов безопасен.
+func (s *Subscription) Unsubscribe() {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	if _, ok := subscribers[s]; ok {
+		delete(subscribers, s)
+		close(s.ch)
+	}
+}
+
+// Lagged сообщает, что подписчик был отключён, потому что не успевал читать события.
+// Имеет смысл после закрытия канала C.
+func (s *Subscription) Lagged() bool {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	return s.lagged
+}
+
+// publish рассылает событие всем подписчикам, не блокируясь на медленных:
+// подписчик с заполненным буфером отключается, чтобы не получать события с пропусками
+func publish(change StatusChange) {
+	change.ChangedAt = time.Now()
+
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	for sub := range subscribers {
+		select {
+		case sub.ch <- change:
+		default:
+			sub.lagged = true
+			delete(subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+func publishTender(tender *models.Tender) {
+	publish(StatusChange{
+		Kind:           KindTender,
+		ID:             tender.ID,
+		TenderID:       tender.ID,
+		OrganizationID: tender.OrganizationID,
+		Status:         tender.Status,
+		Version:        tender.Version,
+		Visibility:     tender.Visibility,
+	})
+}
+
+func publishBid(bid *models.Bid, tender *models.Tender) {
+	publishBidReason(bid, tender, "")
+}
+
+// publishBidReason сообщает об изменении предложения вместе с причиной, которую указал автор.
+// По запечатанному тендеру до вскрытия причина не рассылается: она может раскрыть содержимое предложения.
+func publishBidReason(bid *models.Bid, tender *models.Tender, reason string) {
+	if tender.Sealed && tender.OpenedAt == nil {
+		reason = ""
+	}
+	publish(StatusChange{
+		Kind:           KindBid,
+		ID:             bid.ID,
+		TenderID:       bid.TenderID,
+		OrganizationID: tender.OrganizationID,
+		AuthorID:       bid.AuthorID,
+		Status:         bid.Status,
+		Coordination:   bid.Сoordination,
+		Version:        bid.Version,
+		Reason:         reason,
+	})
+}
+
+// CanWatch сообщает, видит ли пользователь событие. Изменения тендеров видны по тем же правилам,
+// что и тендер в REST (tenderVisible): неопубликованные — только ответственным, по приглашениям —
+// ответственным и приглашённым, не отклонившим приглашение. Изменения предложений видны автору
+// и ответственным за организацию тендера.
+// responsible кэширует проверку ответственности за организацию на время подписки.
+func CanWatch(ctx context.Context, user *models.User, change StatusChange, responsible map[string]bool) bool {
+	if change.Kind == KindBid && change.AuthorID == user.ID {
+		return true
+	}
+	allowed, ok := responsible[change.OrganizationID]
+	if !ok {
+		allowed = database.CheckUserOrganizationResponsibility(ctx, user.ID, change.OrganizationID)
+		responsible[change.OrganizationID] = allowed
+	}
+	if change.Kind == KindBid {
+		return allowed
+	}
+
+	var invitation models.InvitationStatus
+	if !allowed && change.Status == models.Published && change.Visibility == models.VisibilityInviteOnly {
+		if status, err := database.GetInvitationStatus(ctx, change.TenderID, user.ID); err == nil {
+			invitation = status
+		}
+	}
+	return tenderVisible(change.Status, change.Visibility, allowed, invitation)
+}
+
+// GetUser возвращает пользователя по имени, проверяя его существование
+func GetUser(ctx context.Context, username string) (user *models.User, err error) {
+	defer recoverError(&err)
+
+	validateUsername(username)
+	return getAndValidateUserByUsername(ctx, username), nil
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+)
+
+func getAndValidateBidByID(ctx context.Context, bidID string) *models.Bid {
+	bid, err := database.GetBidByID(ctx, bidID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, fmt.Sprintf("Предложение с указанным ID %s не найдено", bidID))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении предложения")
+	}
+	return bid
+}
+
+func getAndValidateUserByUsername(ctx context.Context, username string) *models.User {
+	user, err := database.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusUnauthorized, fmt.Sprintf("Пользователь с именем '%s' не найден", username))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении данных пользователя")
+	}
+	logger.SetUser(ctx, user.Username)
+	return user
+}
+
+func getAndValidateUserByID(ctx context.Context, userID string) *models.User {
+	user, err := database.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusUnauthorized, fmt.Sprintf("Пользователь с ID '%s' не найден", userID))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении данных пользователя")
+	}
+	logger.SetUser(ctx, user.Username)
+	return user
+}
+
+func getAndValidateTenderByID(ctx context.Context, tenderID string) *models.Tender {
+	tender, err := database.GetTenderByID(ctx, tenderID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, fmt.Sprintf("Тендер с ID %s не найден", tenderID))
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении тендера")
+	}
+	return tender
+}
+
+func getAndValidateTenderHistoryVersion(ctx context.Context, tenderID string, version int) *models.TenderHistory {
+	tenderHistoryVersion, err := database.GetTenderHistoryByVersion(ctx, tenderID, version)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, "Версия тендера не найдена")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении версии тендера")
+	}
+	return tenderHistoryVersion
+}
+
+func getAndValidateBidHistoryVersion(ctx context.Context, bidID string, version int) *models.BidHistory {
+	bidHistoryVersion, err := database.GetBidHistoryByVersion(ctx, bidID, version)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, "Версия предложения не найдена")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении версии предложения")
+	}
+	return bidHistoryVersion
+}
+
+func getAndValidateBidByTenderAndAuthorID(ctx context.Context, tenderID, authorID string) *models.Bid {
+	bid, err := database.GetBidByTenderAndAuthorID(ctx, tenderID, authorID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			fail(http.StatusNotFound, "Предложение не найдено")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении предложения")
+	}
+	return bid
+}
+
+// checkTenderAccess пропускает к опубликованному тендеру любого пользователя (username необязателен),
+// к остальным — только ответственных за организацию тендера. К опубликованному тендеру по приглашениям
+// пропускаются ответственные и приглашённые, не отклонившие приглашение.
+func checkTenderAccess(ctx context.Context, tender *models.Tender, username, reason string) {
+	if tender.Status == models.Published && tender.Visibility == models.VisibilityInviteOnly {
+		validateUsername(username)
+		user := getAndValidateUserByUsername(ctx, username)
+		if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+			if !invitationGrantsAccess(getInvitationStatus(ctx, tender.ID, user.ID)) {
+				fail(http.StatusForbidden, reason)
+			}
+		}
+	} else if tender.Status != models.Published {
+		validateUsername(username)
+		user := getAndValidateUserByUsername(ctx, username)
+		if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+			fail(http.StatusForbidden, reason)
+		}
+	} else if username != "" {
+		getAndValidateUserByUsername(ctx, username)
+	}
+}
+
+// tenderVisible — правило checkTenderAccess для пользователя, о котором уже известно,
+// отвечает ли он за организацию тендера и каков статус его приглашения
+func tenderVisible(status models.Status, visibility models.TenderVisibility, responsible bool, invitation models.InvitationStatus) bool {
+	switch {
+	case responsible:
+		return true
+	case status != models.Published:
+		return false
+	case visibility == models.VisibilityInviteOnly:
+		return invitationGrantsAccess(invitation)
+	}
+	return true
+}
+
+// invitationGrantsAccess сообщает, открывает ли приглашение с этим статусом доступ к тендеру
+func invitationGrantsAccess(status models.InvitationStatus) bool {
+	return status != "" && status != models.InvitationDeclined
+}
+
+// checkBidAccess пропускает к предложению автора и ответственных за организацию тендера;
+// ответственных к предложению по запечатанному тендеру — только после вскрытия
+func checkBidAccess(ctx context.Context, bid *models.Bid, user *models.User, reason string) {
+	if user.ID == bid.AuthorID {
+		return
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, reason)
+	}
+	checkBidsOpened(ctx, tender, user)
+}
+
+func saveTenderHistory(ctx context.Context, tender *models.Tender) {
+	tenderHistory := &models.TenderHistory{
+		TenderID:    tender.ID,
+		Name:        tender.Name,
+		Description: tender.Description,
+		ServiceType: tender.ServiceType,
+		Version:     tender.Version,
+	}
+	if err := database.SaveTenderHistory(ctx, tenderHistory); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении истории тендера")
+	}
+}
+
+func saveBidHistory(ctx context.Context, bid *models.Bid) {
+	bidHistory := &models.BidHistory{
+		BidID:         bid.ID,
+		Name:          bid.Name,
+		Description:   bid.Description,
+		Version:       bid.Version,
+		SealedPayload: bid.SealedPayload,
+	}
+	if err := database.SaveBidHistory(ctx, bidHistory); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении истории тендера")
+	}
+}
+
+// checkExpectedVersion проверяет версию, которую клиент передал в If-Match; 0 означает, что проверки нет
+func checkExpectedVersion(expected, current int) {
+	if expected != 0 && expected != current {
+		fail(http.StatusPreconditionFailed, fmt.Sprintf("Версия %d устарела, текущая версия %d", expected, current))
+	}
+}
+
+// updateTender сохраняет тендер, если с момента чтения его версия в базе не изменилась
+func updateTender(ctx context.Context, tender *models.Tender, readVersion int, reason string) {
+	if err := database.UpdateTender(ctx, tender, readVersion); err != nil {
+		if errors.Is(err, database.ErrVersionConflict) {
+			fail(http.StatusPreconditionFailed, "Тендер был изменён другим запросом, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, reason)
+	}
+}
+
+// updateBid сохраняет предложение, если с момента чтения его версия в базе не изменилась
+func updateBid(ctx context.Context, bid *models.Bid, readVersion int, reason string) {
+	if err := database.UpdateBid(ctx, bid, readVersion); err != nil {
+		if errors.Is(err, database.ErrVersionConflict) {
+			fail(http.StatusPreconditionFailed, "Предложение было изменено другим запросом, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, reason)
+	}
+}
+
+func createTender(ctx context.Context, tender *models.TenderRequest) *models.Tender {
+	user, _ := database.GetUserByUsername(ctx, tender.CreatorUsername)
+	visibility := tender.Visibility
+	if visibility == "" {
+		visibility = models.VisibilityPublic
+	}
+	return &models.Tender{
+		Name:              tender.Name,
+		Description:       tender.Description,
+		ServiceType:       tender.ServiceType,
+		Status:            models.Created,
+		OrganizationID:    tender.OrganizationID,
+		CreatorUsernameID: user.ID,
+		Version:           1,
+		BidDeadline:       tender.BidDeadline,
+		Visibility:        visibility,
+	}
+}
+
+func createBid(bid *models.BidRequest) *models.Bid {
+	return &models.Bid{
+		Name:         bid.Name,
+		Description:  bid.Description,
+		Status:       models.Created,
+		TenderID:     bid.TenderID,
+		AuthorType:   bid.AuthorType,
+		AuthorID:     bid.AuthorID,
+		Version:      1,
+		Сoordination: models.Expectation,
+	}
+}
+
+func createTenderResponse(tender *models.Tender) *models.TenderResponse {
+	return &models.TenderResponse{
+		ID:             tender.ID,
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    tender.ServiceType,
+		Status:         tender.Status,
+		OrganizationID: tender.OrganizationID,
+		Version:        tender.Version,
+		CreatedAt:      tender.CreatedAt.Format(time.RFC3339),
+		BidDeadline:    models.FormatTime(tender.BidDeadline),
+		Sealed:         tender.Sealed,
+		OpenedAt:       models.FormatTime(tender.OpenedAt),
+		Visibility:     tender.Visibility,
+	}
+}
+
+func createBidResponse(bid *models.Bid) *models.BidResponse {
+	return &models.BidResponse{
+		ID:          bid.ID,
+		Name:        bid.Name,
+		Description: bid.Description,
+		Status:      bid.Status,
+		TenderID:    bid.TenderID,
+		AuthorType:  bid.AuthorType,
+		AuthorID:    bid.AuthorID,
+		Version:     bid.Version,
+		CreatedAt:   bid.CreatedAt.Format(time.RFC3339),
+		Sealed:      bid.SealedPayload != nil,
+	}
+}
+
+func updateTenderFields(tenderEditRequest *models.TenderEditRequest, tender *models.Tender) {
+	if tenderEditRequest.Name != "" {
+		validateName(tenderEditRequest.Name, "тендера")
+		tender.Name = tenderEditRequest.Name
+	}
+	if tenderEditRequest.Description != "" {
+		validateDescription(tenderEditRequest.Description, "тендера")
+		tender.Description = tenderEditRequest.Description
+	}
+	if tenderEditRequest.ServiceType != "" {
+		validateServiceType(tenderEditRequest.ServiceType)
+		tender.ServiceType = tenderEditRequest.ServiceType
+	}
+	if tenderEditRequest.BidDeadline != nil {
+		validateBidDeadline(tenderEditRequest.BidDeadline)
+		tender.BidDeadline = tenderEditRequest.BidDeadline
+	}
+}
+
+func updateBidFields(bidEditRequest *models.BidEditRequest, bid *models.Bid) {
+	if bidEditRequest.Name != "" {
+		validateName(bidEditRequest.Name, "предложения")
+		bid.Name = bidEditRequest.Name
+	}
+	if bidEditRequest.Description != "" {
+		validateDescription(bidEditRequest.Description, "предложения")
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// InviteToTender приглашает пользователя или организацию к тендеру только по приглашениям.
+// Приглашают ответственные за организацию тендера, пока тендер не закрыт.
+func InviteToTender(ctx context.Context, tenderID, username string, request *models.TenderInvitationRequest) (resp *models.TenderInvitationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	if (request.Username == "") == (request.OrganizationID == "") {
+		fail(http.StatusBadRequest, "Укажите либо имя пользователя, либо ID организации")
+	}
+	if request.OrganizationID != "" {
+		validateID(request.OrganizationID, "ID организации")
+	}
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkInvitationManager(ctx, tender, user)
+	if tender.Visibility != models.VisibilityInviteOnly {
+		fail(http.StatusConflict, "Приглашения используются только в тендерах по приглашениям")
+	}
+	if tender.Status == models.Closed {
+		fail(http.StatusConflict, "Тендер закрыт")
+	}
+
+	invitation := &models.TenderInvitation{TenderID: tender.ID, TenderName: tender.Name, InvitedBy: user.ID}
+	if request.Username != "" {
+		invitee, err := database.GetUserByUsername(ctx, request.Username)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				fail(http.StatusNotFound, "Приглашаемый пользователь не найден")
+			}
+			fail(http.StatusInternalServerError, "Ошибка при получении данных пользователя")
+		}
+		invitation.UserID, invitation.Username = invitee.ID, invitee.Username
+	} else {
+		if request.OrganizationID == tender.OrganizationID {
+			fail(http.StatusBadRequest, "Нельзя пригласить организацию, проводящую тендер")
+		}
+		if _, err := database.GetOrganizationByID(ctx, request.OrganizationID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				fail(http.StatusNotFound, "Организация не найдена")
+			}
+			fail(http.StatusInternalServerError, "Ошибка при получении организации")
+		}
+		invitation.OrganizationID = request.OrganizationID
+	}
+
+	if err := database.SaveTenderInvitation(ctx, invitation); err != nil {
+		if errors.Is(err, database.ErrAlreadyInvited) {
+			fail(http.StatusConflict, "Адресат уже приглашён к тендеру")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении приглашения")
+	}
+	return createInvitationResponse(invitation), nil
+}
+
+// ListTenderInvitations возвращает приглашения к тендеру; доступно ответственным за организацию тендера
+func ListTenderInvitations(ctx context.Context, tenderID, username string, limit, offset int) (invitations []models.TenderInvitationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkInvitationManager(ctx, tender, user)
+
+	stored, err := database.GetTenderInvitations(ctx, tender.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении приглашений")
+	}
+	return createInvitationResponses(stored), nil
+}
+
+// RevokeTenderInvitation отзывает приглашение. Уже поданные по нему предложения остаются,
+// новые адресат подать не сможет.
+func RevokeTenderInvitation(ctx context.Context, tenderID, invitationID, username string) (err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateID(invitationID, "ID приглашения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkInvitationManager(ctx, tender, user)
+
+	if err := database.DeleteTenderInvitation(ctx, tender.ID, invitationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			fail(http.StatusNotFound, "Приглашение не найдено")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при удалении приглашения")
+	}
+	return nil
+}
+
+// ListUserInvitations возвращает приглашения пользователю и организациям, за которые он отвечает
+func ListUserInvitations(ctx context.Context, username string, limit, offset int) (invitations []models.TenderInvitationResponse, err error) {
+	defer recoverError(&err)
+
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	stored, err := database.GetUserInvitations(ctx, user.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении приглашений")
+	}
+	return createInvitationResponses(stored), nil
+}
+
+// RespondToInvitation принимает (accept) или отклоняет приглашение. Отвечает приглашённый пользователь,
+// а за организацию — её ответственный. Ответ окончательный.
+func RespondToInvitation(ctx context.Context, invitationID, username string, accept bool) (resp *models.TenderInvitationResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(invitationID, "ID приглашения")
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	invitation, err := database.GetTenderInvitationByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			fail(http.StatusNotFound, "Приглашение не найдено")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении приглашения")
+	}
+	addressee := invitation.UserID == user.ID ||
+		invitation.OrganizationID != "" && database.CheckUserOrganizationResponsibility(ctx, user.ID, invitation.OrganizationID)
+	if !addressee {
+		fail(http.StatusForbidden, "Приглашение адресовано другому пользователю или организации")
+	}
+
+	invitation.Status, invitation.RespondedBy = models.InvitationDeclined, user.ID
+	if accept {
+		invitation.Status = models.InvitationAccepted
+	}
+	if err := database.RespondTenderInvitation(ctx, invitation); err != nil {
+		if errors.Is(err, database.ErrInvitationState) {
+			fail(http.StatusConflict, "На приглашение уже ответили")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении ответа на приглашение")
+	}
+	return createInvitationResponse(invitation), nil
+}
+
+// checkInvitationAccepted пропускает к участию в тендере по приглашениям — подаче предложений и вопросов —
+// только пользователя с принятым приглашением, личным или своей организации
+func checkInvitationAccepted(ctx context.Context, tender *models.Tender, user *models.User) {
+	if tender.Visibility != models.VisibilityInviteOnly {
+		return
+	}
+	switch getInvitationStatus(ctx, tender.ID, user.ID) {
+	case models.InvitationAccepted:
+	case models.InvitationPending:
+		fail(http.StatusForbidden, "Чтобы участвовать в тендере, примите приглашение")
+	default:
+		fail(http.StatusForbidden, "Тендер только по приглашениям, пользователь не приглашён")
+	}
+}
+
+func checkInvitationManager(ctx context.Context, tender *models.Tender, user *models.User) {
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Управлять приглашениями может только ответственный за организацию тендера")
+	}
+}
+
+func getInvitationStatus(ctx context.Context, tenderID, userID string) models.InvitationStatus {
+	status, err := database.GetInvitationStatus(ctx, tenderID, userID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при проверке приглашения")
+	}
+	return status
+}
+
+func createInvitationResponses(invitations []models.TenderInvitation) []models.TenderInvitationResponse {
+	resp := make([]models.TenderInvitationResponse, len(invitations))
+	for i := range invitations {
+		resp[i] = *createInvitationResponse(&invitations[i])
+	}
+	return resp
+}
+
+func createInvitationResponse(invitation *models.TenderInvitation) *models.TenderInvitationResponse {
+	return &models.TenderInvitationResponse{
+		ID:             invitation.ID,
+		TenderID:       invitation.TenderID,
+		TenderName:     invitation.TenderName,
+		Username:       invitation.Username,
+		OrganizationID: invitation.OrganizationID,
+		Status:         invitation.Status,
+		CreatedAt:      invitation.CreatedAt.Format(time.RFC3339),
+		RespondedAt:    models.FormatTime(invitation.RespondedAt),
+	}
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"tender-service/internal/database"
+	"tender-service/internal/metrics"
+	"tender-service/internal/models"
+)
+
+// maxLots ограничивает число лотов в одном тендере
+const maxLots = 50
+
+// ListTenderLots возвращает лоты тендера. Лоты опубликованного тендера видны всем,
+// остальных — только ответственным за организацию тендера.
+func ListTenderLots(ctx context.Context, tenderID, username string) (lots []models.LotResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра лотов тендера")
+
+	lots = []models.LotResponse{}
+	for _, lot := range getTenderLots(ctx, tender.ID) {
+		lots = append(lots, createLotResponse(&lot))
+	}
+	return lots, nil
+}
+
+func validateLots(lots []models.LotRequest) {
+	if len(lots) > maxLots {
+		fail(http.StatusBadRequest, fmt.Sprintf("Слишком много лотов, максимум %d", maxLots))
+	}
+	for i, lot := range lots {
+		field := fmt.Sprintf("лота %d", i+1)
+		validateName(lot.Name, field)
+		if len(lot.Description) > 500 {
+			fail(http.StatusBadRequest, fmt.Sprintf("Описание %s слишком длинное, максимум 500 символов", field))
+		}
+		if lot.Quantity <= 0 {
+			fail(http.StatusBadRequest, fmt.Sprintf("Количество %s должно быть больше нуля", field))
+		}
+		if lot.Budget <= 0 {
+			fail(http.StatusBadRequest, fmt.Sprintf("Бюджет %s должен быть больше нуля", field))
+		}
+	}
+}
+
+// validateBidLots проверяет, что предложение на тендер с лотами называет лоты этого тендера
+// с ценой в пределах бюджета, а предложение на тендер без лотов их не содержит
+func validateBidLots(requested []models.BidLotRequest, lots []models.Lot) {
+	if len(lots) == 0 {
+		if len(requested) > 0 {
+			fail(http.StatusBadRequest, "У тендера нет лотов, предложение подаётся на тендер целиком")
+		}
+		return
+	}
+	if len(requested) == 0 {
+		fail(http.StatusBadRequest, "Тендер разделён на лоты: укажите лоты предложения и цены по ним")
+	}
+
+	byID := make(map[string]models.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+	seen := map[string]bool{}
+	for _, bidLot := range requested {
+		validateID(bidLot.LotID, "ID лота")
+		lot, ok := byID[bidLot.LotID]
+		if !ok {
+			fail(http.StatusBadRequest, fmt.Sprintf("Лот %s не относится к тендеру", bidLot.LotID))
+		}
+		if seen[bidLot.LotID] {
+			fail(http.StatusBadRequest, fmt.Sprintf("Лот %s указан несколько раз", bidLot.LotID))
+		}
+		seen[bidLot.LotID] = true
+		if bidLot.Price <= 0 {
+			fail(http.StatusBadRequest, fmt.Sprintf("Цена по лоту '%s' должна быть больше нуля", lot.Name))
+		}
+		if bidLot.Price > lot.Budget {
+			fail(http.StatusBadRequest, fmt.Sprintf("Цена по лоту '%s' превышает его бюджет", lot.Name))
+		}
+		if lot.AwardedBidID != "" {
+			fail(http.StatusForbidden, fmt.Sprintf("Лот '%s' уже присуждён", lot.Name))
+		}
+	}
+}
+
+// submitLotDecision — вариант SubmitBidDecision для тендера с лотами. Решение принимается по одному лоту;
+// кворум одобрений присуждает лот предложению и отклоняет по этому лоту остальные предложения.
+// Предложение закрывается, когда решения приняты по всем его лотам.
+func submitLotDecision(ctx context.Context, user *models.User, bid *models.Bid, tender *models.Tender, lots []models.Lot, decision models.Сoordination, lotID, comment string) {
+	bidLots := getBidLots(ctx, bid.ID)
+	lotID = resolveDecisionLot(bidLots, lotID)
+
+	var bidLot *models.BidLot
+	for i := range bidLots {
+		if bidLots[i].LotID == lotID {
+			bidLot = &bidLots[i]
+		}
+	}
+	if bidLot == nil {
+		fail(http.StatusNotFound, "Предложение не участвует в указанном лоте")
+	}
+	if bidLot.Сoordination != models.Expectation {
+		fail(http.StatusForbidden, "Решение по этому лоту уже принято")
+	}
+
+	userDecision := &models.UserDecision{
+		UserID:     user.ID,
+		BidID:      bid.ID,
+		LotID:      lotID,
+		BidVersion: bid.Version,
+		Decision:   decision,
+		Comment:    comment,
+	}
+	if database.CheckUserDecisionExists(ctx, userDecision) {
+		fail(http.StatusForbidden, "пользователь раннее давал свое решение по этому лоту")
+	}
+	saveUserDecision(ctx, userDecision)
+	metrics.DecisionSubmitted(decision)
+
+	if decision == models.Rejected {
+		setBidLotCoordination(ctx, bidLot, models.Rejected)
+		settleBid(ctx, bid, tender, bidLots)
+		return
+	}
+
+	approvals, err := database.GetApprovedDecisionsByBidID(ctx, bid.ID, lotID, bid.Version)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении решений")
+	}
+	if len(approvals) < approvalQuorum {
+		return
+	}
+
+	if err := database.AwardLot(ctx, lotID, bid.ID); err != nil {
+		if errors.Is(err, database.ErrLotAwarded) {
+			fail(http.StatusConflict, "Лот уже присуждён другому предложению")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при присуждении лота")
+	}
+	setBidLotCoordination(ctx, bidLot, models.Approved)
+	price := bidLot.Price
+	createAward(ctx, bid, lotID, &price)
+
+	competitors, err := database.RejectLotCompetitors(ctx, lotID, bid.ID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при обновлении статуса предложения")
+	}
+	for _, competitorID := range competitors {
+		competitor := getAndValidateBidByID(ctx, competitorID)
+		settleBid(ctx, competitor, tender, getBidLots(ctx, competitorID))
+	}
+	settleBid(ctx, bid, tender, bidLots)
+
+	awarded := 0
+	for _, lot := range lots {
+		if lot.AwardedBidID != "" || lot.ID == lotID {
+			awarded++
+		}
+	}
+	if awarded == len(lots) {
+		metrics.TenderAwarded(tender.CreatedAt)
+	}
+}
+
+// resolveDecisionLot возвращает лот, по которому принимается или отзывается решение;
+// если предложение участвует в одном лоте, lotID можно не указывать
+func resolveDecisionLot(bidLots []models.BidLot, lotID string) string {
+	if lotID == "" {
+		if len(bidLots) != 1 {
+			fail(http.StatusBadRequest, "Предложение участвует в нескольких лотах: укажите lotId")
+		}
+		lotID = bidLots[0].LotID
+	}
+	validateID(lotID, "ID лота")
+	return lotID
+}
+
+func setBidLotCoordination(ctx context.Context, bidLot *models.BidLot, coordination models.Сoordination) {
+	if err := database.UpdateBidLotCoordination(ctx, bidLot.BidID, bidLot.LotID, coordination); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при обновлении решения по лоту")
+	}
+	bidLot.Сoordination = coordination
+}
+
+// settleBid закрывает предложение, когда по всем его лотам приняты решения.
+// Предложение считается одобренным, если выиграло хотя бы один лот.
+func settleBid(ctx context.Context, bid *models.Bid, tender *models.Tender, bidLots []models.BidLot) {
+	coordination := models.RejectedByConflict
+	for _, bidLot := range bidLots {
+		switch bidLot.Сoordination {
+		case models.Expectation:
+			return
+		case models.Approved:
+			coordination = models.Approved
+		case models.Rejected:
+			if coordination != models.Approved {
+				coordination = models.Rejected
+			}
+		}
+	}
+
+	bid.Status = models.Closed
+	bid.Сoordination = coordination
+	updateBid(ctx, bid, bid.Version, "Ошибка при обновлении статуса предложения")
+	metrics.BidCoordinated(coordination)
+	publishBid(bid, tender)
+}
+
+func getTenderLots(ctx context.Context, tenderID string) []models.Lot {
+	lots, err := database.GetLotsByTenderIDs(ctx, []string{tenderID})
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении лотов тендера")
+	}
+	return lots
+}
+
+func getBidLots(ctx context.Context, bidID string) []models.BidLot {
+	bidLots, err := database.GetBidLotsByBidIDs(ctx, []string{bidID})
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении лотов предложения")
+	}
+	return bidLots
+}
+
+// attachBidLots дополняет ответы лотами предложений одним запросом на весь список
+func attachBidLots(ctx context.Context, bids []models.BidResponse) {
+	if len(bids) == 0 {
+		return
+	}
+	ids := make([]string, len(bids))
+	index := make(map[string]int, len(bids))
+	for i, bid := range bids {
+		ids[i] = bid.ID
+		index[bid.ID] = i
+	}
+	bidLots, err := database.GetBidLotsByBidIDs(ctx, ids)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении лотов предложений")
+	}
+	for _, bidLot := range bidLots {
+		i := index[bidLot.BidID]
+		bids[i].Lots = append(bids[i].Lots, createBidLotResponse(&bidLot))
+	}
+}
+
+// bidResponse — createBidResponse вместе с лотами предложения. Запечатанное предложение
+// раскрывается в ответе: до вскрытия тендера с ним работает только автор.
+func bidResponse(ctx context.Context, bid *models.Bid) *models.BidResponse {
+	resp := createBidResponse(bid)
+	for _, bidLot := range getBidLots(ctx, bid.ID) {
+		resp.Lots = append(resp.Lots, createBidLotResponse(&bidLot))
+	}
+	if bid.SealedPayload != nil {
+		applySealedContent(resp, unsealBid(getAndValidateTenderByID(ctx, bid.TenderID), bid))
+	}
+	return resp
+}
+
+func createLotResponse(lot *models.Lot) models.LotResponse {
+	return models.LotResponse{
+		ID:           lot.ID,
+		Name:         lot.Name,
+		Description:  lot.Description,
+		Quantity:     lot.Quantity,
+		Budget:       lot.Budget,
+		AwardedBidID: lot.AwardedBidID,
+	}
+}
+
+func createBidLotResponse(bidLot *models.BidLot) models.BidLotResponse {
+	return models.BidLotResponse{
+		LotID:        bidLot.LotID,
+		Price:        bidLot.Price,
+		Coordination: bidLot.Сoordination,
+	}
+}
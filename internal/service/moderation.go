@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// moderationAdmins — пользователи платформы, которые модерируют отзывы по всем тендерам
+var moderationAdmins = map[string]bool{}
+
+// SetupModeration задаёт администраторов платформы для модерации отзывов
+func SetupModeration(admins []string) {
+	moderationAdmins = make(map[string]bool, len(admins))
+	for _, admin := range admins {
+		moderationAdmins[strings.TrimSpace(admin)] = true
+	}
+}
+
+// ReplyToFeedback добавляет в ветку отзыва ответ автора предложения
+func ReplyToFeedback(ctx context.Context, feedbackID, username string, request *models.FeedbackReplyRequest) (resp *models.FeedbackReplyResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(feedbackID, "ID отзыва")
+	validateUsername(username)
+	validateReplyText(request.Text)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	feedback := getAndValidateFeedback(ctx, feedbackID)
+	bid := getAndValidateBidByID(ctx, feedback.BidID)
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "Отвечать на отзыв может только автор предложения")
+	}
+	if feedback.HiddenAt != nil {
+		fail(http.StatusForbidden, "Отзыв скрыт модератором")
+	}
+
+	reply := &models.FeedbackReply{FeedbackID: feedback.ID, AuthorID: user.ID, Username: user.Username, Text: request.Text}
+	if err := database.SaveFeedbackReply(ctx, reply); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении ответа")
+	}
+	created := createFeedbackReplyResponse(reply)
+	return &created, nil
+}
+
+// ReportFeedback подаёт жалобу на отзыв или, если задан replyID, на ответ к нему.
+// Пожаловаться могут автор предложения, ответственные за организацию тендера и администраторы платформы.
+func ReportFeedback(ctx context.Context, feedbackID, replyID, username string, request *models.FeedbackReportRequest) (resp *models.FeedbackReportResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(feedbackID, "ID отзыва")
+	if replyID != "" {
+		validateID(replyID, "ID ответа")
+	}
+	validateUsername(username)
+	validateReason(request.Reason, true)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	feedback := getAndValidateFeedback(ctx, feedbackID)
+	bid := getAndValidateBidByID(ctx, feedback.BidID)
+	if user.ID != bid.AuthorID && !canModerate(ctx, user, bid) {
+		fail(http.StatusForbidden, "Недостаточно прав для жалобы на отзыв")
+	}
+	if moderationHidden(ctx, feedback, replyID) {
+		fail(http.StatusConflict, "Содержимое уже скрыто модератором")
+	}
+
+	report := &models.FeedbackReport{FeedbackID: feedback.ID, ReplyID: replyID, ReporterID: user.ID, Reason: request.Reason}
+	if err := database.SaveFeedbackReport(ctx, report); err != nil {
+		if errors.Is(err, database.ErrAlreadyReported) {
+			fail(http.StatusConflict, "Пользователь уже пожаловался, жалоба ещё не рассмотрена")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при сохранении жалобы")
+	}
+	return &models.FeedbackReportResponse{
+		ID:         report.ID,
+		FeedbackID: report.FeedbackID,
+		ReplyID:    report.ReplyID,
+		Reason:     report.Reason,
+		CreatedAt:  report.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ListModerationQueue возвращает отзывы и ответы с открытыми жалобами, начиная с самых давних.
+// Администратор платформы видит все, ответственный — только по тендерам своих организаций.
+func ListModerationQueue(ctx context.Context, username string, limit, offset int) (items []models.ModerationItemResponse, err error) {
+	defer recoverError(&err)
+
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	admin := moderationAdmins[user.Username]
+	if !admin && !database.CheckUserOrganization(ctx, user.ID) {
+		fail(http.StatusForbidden, "Модерировать отзывы могут ответственные за организации и администраторы")
+	}
+
+	stored, err := database.GetModerationQueue(ctx, admin, user.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении жалоб")
+	}
+	items = make([]models.ModerationItemResponse, len(stored))
+	for i := range stored {
+		items[i] = createModerationItemResponse(&stored[i])
+	}
+	return items, nil
+}
+
+// HideFeedback скрывает отзыв или ответ к нему; скрытое содержимое не показывается в отзывах
+// и не учитывается в репутации
+func HideFeedback(ctx context.Context, feedbackID, replyID, username string, request *models.ModerationRequest) (resp *models.ModerationItemResponse, err error) {
+	defer recoverError(&err)
+	return moderateFeedback(ctx, feedbackID, replyID, username, request, true), nil
+}
+
+// RestoreFeedback возвращает скрытый отзыв или ответ к нему
+func RestoreFeedback(ctx context.Context, feedbackID, replyID, username string, request *models.ModerationRequest) (resp *models.ModerationItemResponse, err error) {
+	defer recoverError(&err)
+	return moderateFeedback(ctx, feedbackID, replyID, username, request, false), nil
+}
+
+// ListBidFeedback возвращает отзывы на предложение с ответами автора, кроме скрытых модератором.
+// Доступно автору предложения и ответственным за организацию тендера.
+func ListBidFeedback(ctx context.Context, bidID, username string, limit, offset int) (reviews []models.FeedbackResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAccess(ctx, bid, user, "Недостаточно прав для просмотра отзывов на предложение")
+
+	reviews, err = database.GetBidReviews(ctx, bid.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении отзывов")
+	}
+	attachFeedbackReplies(ctx, reviews)
+	return reviews, nil
+}
+
+func moderateFeedback(ctx context.Context, feedbackID, replyID, username string, request *models.ModerationRequest, hide bool) *models.ModerationItemResponse {
+	validateID(feedbackID, "ID отзыва")
+	if replyID != "" {
+		validateID(replyID, "ID ответа")
+	}
+	validateUsername(username)
+	validateReason(request.Reason, hide)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	feedback := getAndValidateFeedback(ctx, feedbackID)
+	bid := getAndValidateBidByID(ctx, feedback.BidID)
+	if !canModerate(ctx, user, bid) {
+		fail(http.StatusForbidden, "Недостаточно прав для модерации отзыва")
+	}
+
+	item := &models.ModerationItemResponse{FeedbackID: feedback.ID, BidID: bid.ID, TenderID: bid.TenderID, Text: feedback.BidFeedback, Hidden: hide}
+	entityType, entityID := "feedback", feedback.ID
+	if replyID != "" {
+		reply := getAndValidateFeedbackReply(ctx, feedback.ID, replyID)
+		item.ReplyID, item.Text = reply.ID, reply.Text
+		entityType, entityID = "feedback_reply", reply.ID
+	}
+
+	action := models.AuditFeedbackRestored
+	if hide {
+		action = models.AuditFeedbackHidden
+	}
+	details, _ := json.Marshal(map[string]string{"reason": request.Reason})
+	event := &models.AuditEvent{
+		TenderID:   bid.TenderID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		ActorID:    user.ID,
+		Details:    details,
+	}
+	if err := database.ModerateFeedback(ctx, feedback.ID, replyID, hide, event); err != nil {
+		if errors.Is(err, database.ErrModerationState) {
+			if hide {
+				fail(http.StatusConflict, "Содержимое уже скрыто")
+			}
+			fail(http.StatusConflict, "Содержимое не скрыто")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при модерации отзыва")
+	}
+	return item
+}
+
+// canModerate проверяет, может ли пользователь модерировать отзывы на предложение:
+// это администраторы платформы и ответственные за организацию тендера
+func canModerate(ctx context.Context, user *models.User, bid *models.Bid) bool {
+	if moderationAdmins[user.Username] {
+		return true
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	return database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID)
+}
+
+// moderationHidden сообщает, скрыт ли отзыв или, если задан replyID, ответ к нему
+func moderationHidden(ctx context.Context, feedback *models.Feedback, replyID string) bool {
+	if replyID == "" {
+		return feedback.HiddenAt != nil
+	}
+	return getAndValidateFeedbackReply(ctx, feedback.ID, replyID).HiddenAt != nil
+}
+
+// attachFeedbackReplies дополняет отзывы ветками ответов
+func attachFeedbackReplies(ctx context.Context, reviews []models.FeedbackResponse) {
+	if len(reviews) == 0 {
+		return
+	}
+	ids := make([]string, len(reviews))
+	index := make(map[string]int, len(reviews))
+	for i, review := range reviews {
+		ids[i] = review.ID
+		index[review.ID] = i
+	}
+	replies, err := database.GetFeedbackReplies(ctx, ids)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении ответов на отзывы")
+	}
+	for i := range replies {
+		review := &reviews[index[replies[i].FeedbackID]]
+		review.Replies = append(review.Replies, createFeedbackReplyResponse(&replies[i]))
+	}
+}
+
+func getAndValidateFeedback(ctx context.Context, feedbackID string) *models.Feedback {
+	feedback, err := database.GetFeedbackByID(ctx, feedbackID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			fail(http.StatusNotFound, "Отзыв не найден")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении отзыва")
+	}
+	return feedback
+}
+
+func getAndValidateFeedbackReply(ctx context.Context, feedbackID, replyID string) *models.FeedbackReply {
+	reply, err := database.GetFeedbackReply(ctx, feedbackID, replyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			fail(http.StatusNotFound, "Ответ на отзыв не найден")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении ответа на отзыв")
+	}
+	return reply
+}
+
+func createFeedbackReplyResponse(reply *models.FeedbackReply) models.FeedbackReplyResponse {
+	return models.FeedbackReplyResponse{
+		ID:         reply.ID,
+		FeedbackID: reply.FeedbackID,
+		Author:     reply.Username,
+		Text:       reply.Text,
+		CreatedAt:  reply.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func createModerationItemResponse(item *models.ModerationItem) models.ModerationItemResponse {
+	return models.ModerationItemResponse{
+		FeedbackID:      item.FeedbackID,
+		ReplyID:         item.ReplyID,
+		BidID:           item.BidID,
+		TenderID:        item.TenderID,
+		Text:            item.Text,
+		Reports:         item.Reports,
+		Reasons:         item.Reasons,
+		FirstReportedAt: item.FirstReportedAt.Format(time.RFC3339),
+		Hidden:          item.HiddenAt != nil,
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// GetReputation возвращает репутацию автора предложений по отзывам с оценками на всех тендерах.
+// Автор задаётся так же, как в предложениях, типом authorType, но для организации authorID — ID организации:
+// её репутация складывается из отзывов на предложения, поданные от её имени её ответственными.
+func GetReputation(ctx context.Context, authorType models.AuthorType, authorID, username string) (resp *models.ReputationResponse, err error) {
+	defer recoverError(&err)
+
+	validateAuthorType(authorType)
+	validateID(authorID, "ID автора")
+	validateUsername(username)
+
+	getAndValidateUserByUsername(ctx, username)
+	var userIDs, organizationIDs []string
+	if authorType == models.AuthorTypeOrganization {
+		if _, err := database.GetOrganizationByID(ctx, authorID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				fail(http.StatusNotFound, "Организация не найдена")
+			}
+			fail(http.StatusInternalServerError, "Ошибка при получении организации")
+		}
+		organizationIDs = []string{authorID}
+	} else {
+		if _, err := database.GetUserByID(ctx, authorID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				fail(http.StatusNotFound, "Автор не найден")
+			}
+			fail(http.StatusInternalServerError, "Ошибка при получении данных пользователя")
+		}
+		userIDs = []string{authorID}
+	}
+
+	resp = &models.ReputationResponse{AuthorType: authorType, AuthorID: authorID}
+	for _, reputation := range getReputations(ctx, userIDs, organizationIDs) {
+		resp = createReputationResponse(&reputation)
+	}
+	return resp, nil
+}
+
+// attachReputations дополняет предложения репутацией их авторов. Предложению от имени организации
+// достаётся репутация организации, за которую отвечает автор; если таких несколько — первой по ID.
+// Для организации в AuthorID репутации — ID организации, а не автора предложения.
+func attachReputations(ctx context.Context, bids []models.BidResponse) {
+	if len(bids) == 0 {
+		return
+	}
+	var userIDs, organizationAuthors []string
+	for _, bid := range bids {
+		if bid.AuthorType == models.AuthorTypeOrganization {
+			organizationAuthors = append(organizationAuthors, bid.AuthorID)
+		} else {
+			userIDs = append(userIDs, bid.AuthorID)
+		}
+	}
+
+	authorOrganization := map[string]string{}
+	var organizationIDs []string
+	if len(organizationAuthors) > 0 {
+		responsibles, err := database.GetAuthorOrganizations(ctx, organizationAuthors)
+		if err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при расчёте репутации")
+		}
+		for _, responsible := range responsibles {
+			if _, ok := authorOrganization[responsible.UserID]; !ok {
+				authorOrganization[responsible.UserID] = responsible.OrganizationID
+				organizationIDs = append(organizationIDs, responsible.OrganizationID)
+			}
+		}
+	}
+
+	byAuthor := make(map[models.AuthorType]map[string]*models.ReputationResponse)
+	for _, reputation := range getReputations(ctx, userIDs, organizationIDs) {
+		if byAuthor[reputation.AuthorType] == nil {
+			byAuthor[reputation.AuthorType] = make(map[string]*models.ReputationResponse)
+		}
+		byAuthor[reputation.AuthorType][reputation.AuthorID] = createReputationResponse(&reputation)
+	}
+	for i := range bids {
+		authorID := bids[i].AuthorID
+		if bids[i].AuthorType == models.AuthorTypeOrganization {
+			var ok bool
+			if authorID, ok = authorOrganization[authorID]; !ok {
+				// Автор больше не отвечает ни за одну организацию: чью репутацию показать, неизвестно
+				continue
+			}
+		}
+		reputation := byAuthor[bids[i].AuthorType][authorID]
+		if reputation == nil {
+			reputation = &models.ReputationResponse{AuthorType: bids[i].AuthorType, AuthorID: authorID}
+		}
+		bids[i].AuthorReputation = reputation
+	}
+}
+
+func getReputations(ctx context.Context, userIDs, organizationIDs []string) []models.Reputation {
+	reputations, err := database.GetReputations(ctx, userIDs, organizationIDs)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при расчёте репутации")
+	}
+	return reputations
+}
+
+func createReputationResponse(reputation *models.Reputation) *models.ReputationResponse {
+	resp := &models.ReputationResponse{
+		AuthorType: reputation.AuthorType,
+		AuthorID:   reputation.AuthorID,
+		Reviews:    reputation.Reviews,
+		Tenders:    reputation.Tenders,
+	}
+	if reputation.Reviews > 0 {
+		resp.Ratings = &models.RatingAverages{
+			Quality:       roundRating(reputation.Quality),
+			Timeliness:    roundRating(reputation.Timeliness),
+			Communication: roundRating(reputation.Communication),
+			Overall:       roundRating((reputation.Quality + reputation.Timeliness + reputation.Communication) / 3),
+		}
+	}
+	return resp
+}
+
+// roundRating округляет среднюю оценку до сотых
+func roundRating(rating float64) float64 {
+	return math.Round(rating*100) / 100
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tender-service/internal/database"
+	"tender-service/internal/logger"
+	"tender-service/internal/models"
+	"tender-service/internal/sealing"
+)
+
+// sealedBidName заменяет название запечатанного предложения до вскрытия тендера
+const sealedBidName = "Запечатанное предложение"
+
+// openBatchSize ограничивает число тендеров, вскрываемых за один запуск фоновой задачи
+const openBatchSize = 100
+
+var keyring *sealing.Keyring
+
+// SetupSealing включает запечатанные тендеры; без мастер-ключа их создание недоступно
+func SetupSealing(k *sealing.Keyring) {
+	keyring = k
+}
+
+// ListAuditEvents возвращает журнал событий тендера; доступно ответственным за организацию тендера
+func ListAuditEvents(ctx context.Context, tenderID, username string, limit, offset int) (events []models.AuditEventResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для просмотра журнала тендера")
+	}
+
+	stored, err := database.GetAuditEvents(ctx, tender.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении журнала тендера")
+	}
+	events = make([]models.AuditEventResponse, len(stored))
+	for i := range stored {
+		events[i] = createAuditEventResponse(&stored[i])
+	}
+	return events, nil
+}
+
+// OpenDueSealedTenders вскрывает запечатанные тендеры, срок подачи по которым истёк.
+// Тендер, который не удалось вскрыть, попадает в лог и не мешает вскрытию остальных.
+func OpenDueSealedTenders(ctx context.Context) error {
+	if keyring == nil {
+		return nil
+	}
+	ids, err := database.GetDueSealedTenderIDs(ctx, openBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		tender, err := database.GetTenderByID(ctx, id)
+		if err == nil {
+			err = openSealedTender(ctx, tender, "")
+		}
+		if err != nil {
+			logger.FromContext(ctx).Error("failed to open sealed tender", "tender_id", id, "error", err)
+		}
+	}
+	return nil
+}
+
+// checkBidsOpened открывает предложения запечатанного тендера для ответственных: до срока подачи
+// запрос отклоняется, а после срока тендер вскрывается, если фоновая задача ещё не успела.
+// Возвращает true, если тендер вскрыт этим вызовом и прочитанные ранее предложения устарели.
+func checkBidsOpened(ctx context.Context, tender *models.Tender, user *models.User) bool {
+	opened := openIfDue(ctx, tender, user)
+	if tender.Sealed && tender.OpenedAt == nil {
+		fail(http.StatusForbidden, "Предложения по запечатанному тендеру будут доступны после истечения срока подачи")
+	}
+	return opened
+}
+
+// openIfDue вскрывает запечатанный тендер с истёкшим сроком подачи и сообщает, был ли он вскрыт сейчас
+func openIfDue(ctx context.Context, tender *models.Tender, user *models.User) bool {
+	if !tender.Sealed || tender.OpenedAt != nil || !bidDeadlinePassed(tender) {
+		return false
+	}
+	getKeyring()
+	if err := openSealedTender(ctx, tender, user.ID); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при вскрытии тендера")
+	}
+	if tender.OpenedAt == nil {
+		// Тендер вскрыт параллельным запросом
+		current := getAndValidateTenderByID(ctx, tender.ID)
+		tender.OpenedAt = current.OpenedAt
+		tender.SealedKey = nil
+	}
+	return true
+}
+
+func bidDeadlinePassed(tender *models.Tender) bool {
+	return tender.BidDeadline != nil && !time.Now().Before(*tender.BidDeadline)
+}
+
+// openSealedTender расшифровывает предложения тендера и записывает вскрытие в журнал.
+// actorID пуст, если вскрытие выполнила фоновая задача.
+func openSealedTender(ctx context.Context, tender *models.Tender, actorID string) error {
+	key, err := keyring.TenderKey(tender.ID, tender.SealedKey)
+	if err != nil {
+		return err
+	}
+	event := &models.AuditEvent{
+		TenderID:   tender.ID,
+		EntityType: "tender",
+		EntityID:   tender.ID,
+		Action:     models.AuditTenderOpened,
+		ActorID:    actorID,
+	}
+	reveal := func(bidID string, payload []byte) (*models.SealedBid, error) {
+		return decryptBid(key, bidID, payload)
+	}
+	opened, err := database.OpenSealedTender(ctx, tender.ID, reveal, event)
+	if err != nil {
+		return err
+	}
+	if opened {
+		tender.OpenedAt = &event.CreatedAt
+		tender.SealedKey = nil
+		logger.FromContext(ctx).Info("sealed tender opened", "tender_id", tender.ID, "details", string(event.Details))
+	}
+	return nil
+}
+
+// sealTender выпускает ключ для нового запечатанного тендера; ID назначается заранее,
+// потому что он связан с зашифрованным ключом
+func sealTender(tender *models.Tender) {
+	tender.ID = uuid.NewString()
+	_, wrapped, err := getKeyring().NewTenderKey(tender.ID)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при создании ключа тендера")
+	}
+	tender.Sealed = true
+	tender.SealedKey = wrapped
+}
+
+// createSealedBid сохраняет предложение по запечатанному тендеру: название, описание и цены по лотам
+// шифруются, а в открытых полях остаются заглушки
+func createSealedBid(ctx context.Context, tender *models.Tender, bid *models.Bid, bidRequest *models.BidRequest) {
+	bid.ID = uuid.NewString()
+	sealBid(tender, bid, &models.SealedBid{Name: bidRequest.Name, Description: bidRequest.Description, Lots: bidRequest.Lots})
+
+	bidLots := make([]models.BidLot, len(bidRequest.Lots))
+	for i, lot := range bidRequest.Lots {
+		bidLots[i] = models.BidLot{LotID: lot.LotID, Сoordination: models.Expectation}
+	}
+	if err := database.SaveSealedBid(ctx, bid, bidLots); err != nil {
+		if errors.Is(err, database.ErrTenderOpened) {
+			fail(http.StatusForbidden, "Срок подачи предложений по тендеру истёк")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при создании предложения")
+	}
+}
+
+// editSealedBid применяет правку к зашифрованному содержимому предложения
+func editSealedBid(tender *models.Tender, bid *models.Bid, bidEditRequest *models.BidEditRequest) {
+	content := unsealBid(tender, bid)
+	edited := models.Bid{Name: content.Name, Description: content.Description}
+	updateBidFields(bidEditRequest, &edited)
+	content.Name = edited.Name
+	content.Description = edited.Description
+	sealBid(tender, bid, content)
+}
+
+// checkSealedBidEditable запрещает менять предложения по запечатанному тендеру после срока подачи:
+// после вскрытия участники не должны подстраиваться под чужие предложения
+func checkSealedBidEditable(tender *models.Tender) {
+	if tender.Sealed && (tender.OpenedAt != nil || bidDeadlinePassed(tender)) {
+		fail(http.StatusForbidden, "Срок подачи предложений по запечатанному тендеру истёк, изменить предложение нельзя")
+	}
+}
+
+// sealBid шифрует содержимое предложения ключом тендера, а в открытые поля записывает заглушки
+func sealBid(tender *models.Tender, bid *models.Bid, content *models.SealedBid) {
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при шифровании предложения")
+	}
+	payload, err := sealing.SealBid(tenderKey(tender), bid.ID, plaintext)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при шифровании предложения")
+	}
+	bid.Name = sealedBidName
+	bid.Description = ""
+	bid.SealedPayload = payload
+}
+
+// unsealBid возвращает содержимое запечатанного предложения; вызывается только для автора
+func unsealBid(tender *models.Tender, bid *models.Bid) *models.SealedBid {
+	content, err := decryptBid(tenderKey(tender), bid.ID, bid.SealedPayload)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при расшифровке предложения")
+	}
+	return content
+}
+
+func getKeyring() *sealing.Keyring {
+	if keyring == nil {
+		fail(http.StatusServiceUnavailable, "Запечатанные тендеры недоступны: не задан мастер-ключ")
+	}
+	return keyring
+}
+
+func tenderKey(tender *models.Tender) []byte {
+	key, err := getKeyring().TenderKey(tender.ID, tender.SealedKey)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении ключа тендера")
+	}
+	return key
+}
+
+func decryptBid(key []byte, bidID string, payload []byte) (*models.SealedBid, error) {
+	plaintext, err := sealing.OpenBid(key, bidID, payload)
+	if err != nil {
+		return nil, err
+	}
+	var content models.SealedBid
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+// revealBidResponses подставляет автору содержимое его запечатанных предложений одним запросом на весь список
+func revealBidResponses(ctx context.Context, bids []models.BidResponse) {
+	var ids []string
+	index := make(map[string]int, len(bids))
+	for i, bid := range bids {
+		if bid.Sealed {
+			ids = append(ids, bid.ID)
+			index[bid.ID] = i
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	payloads, err := database.GetSealedPayloads(ctx, ids)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении предложений")
+	}
+	keys := map[string][]byte{}
+	for _, payload := range payloads {
+		key, ok := keys[payload.TenderID]
+		if !ok {
+			key = tenderKey(&models.Tender{ID: payload.TenderID, SealedKey: payload.SealedKey})
+			keys[payload.TenderID] = key
+		}
+		content, err := decryptBid(key, payload.BidID, payload.Payload)
+		if err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при расшифровке предложения")
+		}
+		applySealedContent(&bids[index[payload.BidID]], content)
+	}
+}
+
+func applySealedContent(resp *models.BidResponse, content *models.SealedBid) {
+	resp.Name = content.Name
+	resp.Description = content.Description
+	prices := make(map[string]float64, len(content.Lots))
+	for _, lot := range content.Lots {
+		prices[lot.LotID] = lot.Price
+	}
+	for i := range resp.Lots {
+		resp.Lots[i].Price = prices[resp.Lots[i].LotID]
+	}
+}
+
+func createAuditEventResponse(event *models.AuditEvent) models.AuditEventResponse {
+	return models.AuditEventResponse{
+		ID:         event.ID,
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Action:     event.Action,
+		ActorID:    event.ActorID,
+		Details:    event.Details,
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+func CreateTender(ctx context.Context, tenderRequest *models.TenderRequest) (resp *models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	validateName(tenderRequest.Name, "тендера")
+	validateDescription(tenderRequest.Description, "тендера")
+	validateServiceType(tenderRequest.ServiceType)
+	validateID(tenderRequest.OrganizationID, "ID организации")
+	validateUsername(tenderRequest.CreatorUsername)
+	validateLots(tenderRequest.Lots)
+	validateBidDeadline(tenderRequest.BidDeadline)
+	validateVisibility(tenderRequest.Visibility)
+	if tenderRequest.Sealed && tenderRequest.BidDeadline == nil {
+		fail(http.StatusBadRequest, "Для запечатанного тендера нужно указать срок подачи предложений")
+	}
+	if tenderRequest.Auction != nil {
+		validateAuction(tenderRequest.Auction)
+		if len(tenderRequest.Lots) > 0 {
+			fail(http.StatusBadRequest, "Торги проводятся только по тендеру без лотов")
+		}
+		if tenderRequest.Sealed {
+			fail(http.StatusBadRequest, "Запечатанный тендер не может проводиться в виде торгов")
+		}
+	}
+
+	user := getAndValidateUserByUsername(ctx, tenderRequest.CreatorUsername)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tenderRequest.OrganizationID) {
+		fail(http.StatusForbidden, "Пользователь не имеет прав для создания тендеров от имени этой организации")
+	}
+
+	tender := createTender(ctx, tenderRequest)
+	if tenderRequest.Sealed {
+		sealTender(tender)
+	}
+
+	if err := database.SaveTender(ctx, tender); err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при сохранении тендера")
+	}
+	if len(tenderRequest.Lots) > 0 {
+		lots := make([]models.Lot, len(tenderRequest.Lots))
+		for i, lot := range tenderRequest.Lots {
+			lots[i] = models.Lot{Name: lot.Name, Description: lot.Description, Quantity: lot.Quantity, Budget: lot.Budget}
+		}
+		if err := database.SaveLots(ctx, tender.ID, lots); err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при сохранении лотов тендера")
+		}
+	}
+	if auction := tenderRequest.Auction; auction != nil {
+		if err := database.SaveAuction(ctx, &models.Auction{
+			TenderID:     tender.ID,
+			StartsAt:     auction.StartsAt,
+			EndsAt:       auction.EndsAt,
+			MinDecrement: auction.MinDecrement,
+			Extension:    auction.Extension,
+		}); err != nil {
+			fail(http.StatusInternalServerError, "Ошибка при сохранении торгов")
+		}
+	}
+	return createTenderResponse(tender), nil
+}
+
+// ListTenders возвращает опубликованные тендеры; пустой serviceTypes означает все типы
+func ListTenders(ctx context.Context, serviceTypes []string, limit, offset int) (tenders []models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	for _, serviceType := range serviceTypes {
+		validateServiceType(models.ServiceType(serviceType))
+	}
+	limit, offset = validatePage(limit, offset)
+
+	tenders, err = database.GetTendersResponse(ctx, serviceTypes, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении тендеров")
+	}
+	return tenders, nil
+}
+
+func ListUserTenders(ctx context.Context, username string, limit, offset int) (tenders []models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	limit, offset = validatePage(limit, offset)
+
+	tenders, err = database.GetTendersByUsername(ctx, user.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении тендеров пользователя")
+	}
+	return tenders, nil
+}
+
+// GetTenderStatus возвращает статус тендера и его текущую версию. Доступ — как к самому тендеру
+// (checkTenderAccess): username обязателен для неопубликованного тендера и тендера по приглашениям.
+func GetTenderStatus(ctx context.Context, tenderID, username string) (status models.Status, version int, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	checkTenderAccess(ctx, tender, username, "Недостаточно прав для просмотра статуса тендера")
+	return tender.Status, tender.Version, nil
+}
+
+// UpdateTenderStatus меняет статус тендера; expectedVersion (0 — без проверки) должна совпадать с текущей версией
+func UpdateTenderStatus(ctx context.Context, tenderID string, newStatus models.Status, username string, expectedVersion int) (resp *models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateStatus(newStatus)
+	validateUsername(username)
+
+	user := getAndValidateUserByUsername(ctx, username)
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Пользователь не имеет отвественности за организацию текущего тендера для изменения статуса")
+	}
+	checkExpectedVersion(expectedVersion, tender.Version)
+
+	tender.Status = newStatus
+
+	updateTender(ctx, tender, tender.Version, "Ошибка при обновлении статуса тендера")
+	publishTender(tender)
+
+	return createTenderResponse(tender), nil
+}
+
+// EditTender изменяет тендер и сохраняет прежнюю версию в историю
+func EditTender(ctx context.Context, tenderID, username string, tenderEditRequest *models.TenderEditRequest, expectedVersion int) (resp *models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	user := getAndValidateUserByUsername(ctx, username)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для редактирования тендера")
+	}
+	checkExpectedVersion(expectedVersion, tender.Version)
+
+	if tenderEditRequest.Name == "" && tenderEditRequest.Description == "" && tenderEditRequest.ServiceType == "" && tenderEditRequest.BidDeadline == nil {
+		fail(http.StatusBadRequest, "Отправлен пустой запрос")
+	}
+
+	if tenderEditRequest.BidDeadline != nil && tender.Sealed && (tender.OpenedAt != nil || bidDeadlinePassed(tender)) {
+		fail(http.StatusForbidden, "Срок подачи по запечатанному тендеру истёк, изменить его нельзя")
+	}
+
+	copyTender := *tender
+
+	updateTenderFields(tenderEditRequest, tender)
+	tender.Version++
+
+	// История пишется после условного обновления: из параллельных правок одной версии
+	// проходит только одна, и запись истории не дублируется
+	updateTender(ctx, tender, copyTender.Version, "Ошибка при обновлении тендера")
+	saveTenderHistory(ctx, &copyTender)
+	return createTenderResponse(tender), nil
+}
+
+func RollbackTender(ctx context.Context, tenderID string, version int, username string, expectedVersion int) (resp *models.TenderResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(tenderID, "ID тендера")
+	validateUsername(username)
+
+	tender := getAndValidateTenderByID(ctx, tenderID)
+	user := getAndValidateUserByUsername(ctx, username)
+
+	validateVersion(version, tender.Version)
+
+	if !database.CheckUserOrganizationResponsibility(ctx, user.ID, tender.OrganizationID) {
+		fail(http.StatusForbidden, "Недостаточно прав для выполнения действия")
+	}
+	checkExpectedVersion(expectedVersion, tender.Version)
+
+	tenderHistoryVersion := getAndValidateTenderHistoryVersion(ctx, tender.ID, version)
+
+	copyTender := *tender
+
+	tender.Name = tenderHistoryVersion.Name
+	tender.Description = tenderHistoryVersion.Description
+	tender.ServiceType = tenderHistoryVersion.ServiceType
+	tender.Version++
+
+	updateTender(ctx, tender, copyTender.Version, "Ошибка при обновлении тендера")
+	saveTenderHistory(ctx, &copyTender)
+	return createTenderResponse(tender), nil
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tender-service/internal/models"
+)
+
+func validateName(name string, fieldName string) {
+	if name == "" {
+		fail(http.StatusBadRequest, fmt.Sprintf("Название %s не может быть пустым", fieldName))
+	}
+	if len(name) > 100 {
+		fail(http.StatusBadRequest, fmt.Sprintf("Название %s слишком длинное, максимум 100 символов", fieldName))
+	}
+}
+
+func validateDescription(description string, fieldName string) {
+	if description == "" {
+		fail(http.StatusBadRequest, fmt.Sprintf("Описание %s не может быть пустым", fieldName))
+	}
+	if len(description) > 500 {
+		fail(http.StatusBadRequest, fmt.Sprintf("Описание %s слишком длинное, максимум 500 символов", fieldName))
+	}
+}
+
+func validateID(id string, idType string) {
+	if id == "" {
+		fail(http.StatusBadRequest, fmt.Sprintf("%s не может быть пустым", idType))
+
+	}
+	if len(id) > 100 {
+		fail(http.StatusBadRequest, fmt.Sprintf("%s слишком длинное, максимум 100 символов", idType))
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		fail(http.StatusBadRequest, fmt.Sprintf("Некорректный формат %s", idType))
+	}
+}
+
+func validateStatus(status models.Status) {
+	if status == "" {
+		fail(http.StatusBadRequest, "Статус не может быть пустым")
+	}
+	validStatuses := map[models.Status]bool{
+		models.Created:   true,
+		models.Published: true,
+		models.Closed:    true,
+	}
+	if !validStatuses[status] {
+		fail(http.StatusBadRequest, "Некорректный статус. Допустимые значения: Created, Published, Closed")
+	}
+}
+
+func validateBidStatus(status models.Status) {
+	if status == "" {
+		fail(http.StatusBadRequest, "Статус не может быть пустым")
+	}
+	validStatuses := map[models.Status]bool{
+		models.Created:   true,
+		models.Published: true,
+		models.Canceled:  true,
+	}
+	if !validStatuses[status] {
+		fail(http.StatusBadRequest, "Некорректный статус. Допустимые значения: Created, Published, Canceled")
+	}
+}
+
+func validateAuthorType(authorType models.AuthorType) {
+	if authorType == "" {
+		fail(http.StatusBadRequest, "Тип автора не может быть пустым")
+	}
+	validAuthorTypes := map[models.AuthorType]bool{
+		models.AuthorTypeOrganization: true,
+		models.AuthorTypeUser:         true,
+	}
+	if !validAuthorTypes[authorType] {
+		fail(http.StatusBadRequest, "Некорректный тип автора. Допустимые значения: Organization, User")
+	}
+}
+
+func validateVisibility(visibility models.TenderVisibility) {
+	switch visibility {
+	case "", models.VisibilityPublic, models.VisibilityInviteOnly:
+	default:
+		fail(http.StatusBadRequest, "Некорректная видимость тендера. Допустимые значения: Public, InviteOnly")
+	}
+}
+
+func validateServiceType(serviceType models.ServiceType) {
+	if serviceType == "" {
+		fail(http.StatusBadRequest, "Тип услуги не может быть пустым")
+	}
+	validServiceTypes := map[models.ServiceType]bool{
+		models.Construction: true,
+		models.Delivery:     true,
+		models.Manufacture:  true,
+	}
+	if !validServiceTypes[serviceType] {
+		fail(http.StatusBadRequest, "Некорректный тип услуги. Допустимые значения: Construction, Delivery, Manufacture")
+	}
+}
+
+func validateDecision(decision models.Сoordination) {
+	if decision == "" {
+		fail(http.StatusBadRequest, "Решение не может быть пустым")
+	}
+	validDecisions := map[models.Сoordination]bool{
+		models.Approved: true,
+		models.Rejected: true,
+	}
+	if !validDecisions[decision] {
+		fail(http.StatusBadRequest, "Некорректное решение. Допустимые значения: Approved, Rejected")
+	}
+}
+
+func validateUsername(username string) {
+	if username == "" {
+		fail(http.StatusBadRequest, "Имя пользователя не может быть пустым")
+	}
+	if len(username) > 50 {
+		fail(http.StatusBadRequest, "Имя пользователя слишком длинное, максимум 50 символов")
+	}
+	validUsernameRegex := `^[a-zA-Z0-9_-]+$`
+	matched, err := regexp.MatchString(validUsernameRegex, username)
+	if err != nil || !matched {
+		fail(http.StatusBadRequest, "Имя пользователя содержит недопустимые символы. Разрешены только буквы, цифры, дефисы и символы подчеркивания")
+	}
+}
+
+func validateFeedback(feedback string) {
+	if feedback == "" {
+		fail(http.StatusBadRequest, "Отзыв не может быть пустым")
+	}
+	if len(feedback) > 1000 {
+		fail(http.StatusBadRequest, "Отзыв слишком длинный, максимум 1000 символов")
+	}
+}
+
+// validateRatings проверяет оценки отзыва; отзыв без оценок допустим
+func validateRatings(ratings *models.FeedbackRatings) {
+	if ratings == nil {
+		return
+	}
+	for _, rating := range []int{ratings.Quality, ratings.Timeliness, ratings.Communication} {
+		if rating < 1 || rating > 5 {
+			fail(http.StatusBadRequest, "Оценки качества, сроков и коммуникации должны быть от 1 до 5")
+		}
+	}
+}
+
+func validateBidDeadline(deadline *time.Time) {
+	if deadline != nil && !deadline.After(time.Now()) {
+		fail(http.StatusBadRequest, "Срок подачи предложений должен быть в будущем")
+	}
+}
+
+// checkBidDeadline запрещает подачу предложений и вопросов по тендеру после истечения срока
+func checkBidDeadline(tender *models.Tender) {
+	if tender.BidDeadline != nil && !time.Now().Before(*tender.BidDeadline) {
+		fail(http.StatusForbidden, "Срок подачи предложений по тендеру истёк")
+	}
+}
+
+func validateClarificationText(text, fieldName string) {
+	if text == "" {
+		fail(http.StatusBadRequest, fmt.Sprintf("%s не может быть пустым", fieldName))
+	}
+	if len(text) > 1000 {
+		fail(http.StatusBadRequest, fmt.Sprintf("%s слишком длинный, максимум 1000 символов", fieldName))
+	}
+}
+
+// validateReason проверяет причину отзыва или повторной подачи предложения; пустая допустима, если она не обязательна
+func validateReason(reason string, required bool) {
+	if required && reason == "" {
+		fail(http.StatusBadRequest, "Укажите причину")
+	}
+	if len(reason) > 1000 {
+		fail(http.StatusBadRequest, "Причина слишком длинная, максимум 1000 символов")
+	}
+}
+
+func validateAwardStatus(status models.AwardStatus) {
+	switch status {
+	case models.AwardActive, models.AwardCompleted, models.AwardTerminated:
+	default:
+		fail(http.StatusBadRequest, "Некорректный статус договора. Допустимые значения: Active, Completed, Terminated")
+	}
+}
+
+func validateMilestone(request *models.AwardMilestoneRequest) {
+	if request.Name == "" || len(request.Name) > 100 {
+		fail(http.StatusBadRequest, "Название этапа обязательно, максимум 100 символов")
+	}
+	if len(request.Description) > 500 {
+		fail(http.StatusBadRequest, "Описание этапа слишком длинное, максимум 500 символов")
+	}
+	if request.DueDate == nil {
+		fail(http.StatusBadRequest, "Укажите срок этапа")
+	}
+}
+
+func validateReplyText(text string) {
+	if text == "" {
+		fail(http.StatusBadRequest, "Ответ не может быть пустым")
+	}
+	if len(text) > 1000 {
+		fail(http.StatusBadRequest, "Ответ слишком длинный, максимум 1000 символов")
+	}
+}
+
+func validateDecisionComment(comment string) {
+	if len(comment) > 1000 {
+		fail(http.StatusBadRequest, "Комментарий слишком длинный, максимум 1000 символов")
+	}
+}
+
+func validateVersion(version int, currentVersion int) {
+	if version < 1 || currentVersion <= version {
+		fail(http.StatusBadRequest, "Некорректная версия")
+	}
+}
+
+// DefaultLimit — размер страницы, если клиент его не указал
+const DefaultLimit = 5
+
+// validatePage проверяет параметры пагинации; нулевой limit заменяется на DefaultLimit
+func validatePage(limit, offset int) (int, int) {
+	if limit < 0 {
+		fail(http.StatusBadRequest, "Некорректный параметр лимита")
+	}
+	if offset < 0 {
+		fail(http.StatusBadRequest, "Некорректный параметр смещения")
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	return limit, offset
+}
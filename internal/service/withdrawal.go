@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"tender-service/internal/database"
+	"tender-service/internal/models"
+)
+
+// WithdrawBid отзывает опубликованное предложение, пока по нему не принято окончательное решение.
+// Решения, принятые до отзыва, сохраняются; ответственные за организацию тендера получают событие с причиной,
+// а отзыв записывается в журнал событий тендера.
+func WithdrawBid(ctx context.Context, bidID, username string, request *models.BidWithdrawalRequest, expectedVersion int) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	validateReason(request.Reason, true)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	checkExpectedVersion(expectedVersion, bid.Version)
+	checkDecisionPending(ctx, bid)
+	if bid.Status != models.Published {
+		fail(http.StatusForbidden, "Отозвать можно только опубликованное предложение")
+	}
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+
+	withdrawal := &models.BidWithdrawal{Reason: request.Reason, WithdrawnBy: user.ID}
+	event := bidWithdrawalEvent(bid, tender, user, models.AuditBidWithdrawn, map[string]any{"reason": request.Reason})
+	if err := database.WithdrawBid(ctx, bid, withdrawal, event); err != nil {
+		if errors.Is(err, database.ErrVersionConflict) {
+			fail(http.StatusConflict, "Предложение изменено другим запросом или по нему уже принято решение, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при отзыве предложения")
+	}
+	bid.Status = models.Canceled
+	publishBidReason(bid, tender, request.Reason)
+
+	return bidResponse(ctx, bid), nil
+}
+
+// ResubmitBid снова публикует отозванное предложение. Если после отзыва предложение изменили,
+// решения, принятые по нему раньше, аннулируются и согласование начинается заново. Повторная подача,
+// как и отзыв, записывается в журнал событий тендера.
+func ResubmitBid(ctx context.Context, bidID, username string, request *models.BidWithdrawalRequest, expectedVersion int) (resp *models.BidResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	validateReason(request.Reason, false)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	if user.ID != bid.AuthorID {
+		fail(http.StatusForbidden, "пользователь не имеет доступа к предложению")
+	}
+	checkExpectedVersion(expectedVersion, bid.Version)
+	checkDecisionPending(ctx, bid)
+
+	withdrawal := getOpenBidWithdrawal(ctx, bid.ID)
+	if withdrawal == nil || bid.Status != models.Canceled {
+		fail(http.StatusConflict, "Предложение не отозвано")
+	}
+
+	tender := getAndValidateTenderByID(ctx, bid.TenderID)
+	if tender.Status != models.Published {
+		fail(http.StatusForbidden, "Тендер ещё не опубликован или закрыт")
+	}
+	checkBidDeadline(tender)
+	if auction := getTenderAuction(ctx, tender.ID); auction != nil {
+		checkAuctionOpen(auction)
+	}
+
+	withdrawal.ResubmitReason = request.Reason
+	withdrawal.DecisionsReset = bid.Version != withdrawal.Version
+	details := map[string]any{"reason": request.Reason, "decisionsReset": withdrawal.DecisionsReset}
+	event := bidWithdrawalEvent(bid, tender, user, models.AuditBidResubmitted, details)
+	if err := database.ResubmitBid(ctx, bid, withdrawal, event); err != nil {
+		if errors.Is(err, database.ErrVersionConflict) {
+			fail(http.StatusConflict, "Предложение изменено другим запросом, получите актуальную версию и повторите запрос")
+		}
+		fail(http.StatusInternalServerError, "Ошибка при повторной подаче предложения")
+	}
+	bid.Status = models.Published
+	publishBidReason(bid, tender, request.Reason)
+
+	return bidResponse(ctx, bid), nil
+}
+
+// ListBidWithdrawals возвращает отзывы предложения от последнего к первому;
+// доступно автору и ответственным за организацию тендера
+func ListBidWithdrawals(ctx context.Context, bidID, username string, limit, offset int) (withdrawals []models.BidWithdrawalResponse, err error) {
+	defer recoverError(&err)
+
+	validateID(bidID, "ID предложения")
+	validateUsername(username)
+	limit, offset = validatePage(limit, offset)
+
+	user := getAndValidateUserByUsername(ctx, username)
+	bid := getAndValidateBidByID(ctx, bidID)
+	checkBidAccess(ctx, bid, user, "Недостаточно прав для просмотра отзывов предложения")
+
+	stored, err := database.GetBidWithdrawals(ctx, bid.ID, limit, offset)
+	if err != nil {
+		fail(http.StatusInternalServerError, "Ошибка при получении отзывов предложения")
+	}
+	withdrawals = make([]models.BidWithdrawalResponse, len(stored))
+	for i := range stored {
+		withdrawals[i] = createBidWithdrawalResponse(&stored[i])
+	}
+	return withdrawals, nil
+}
+
+// bidWithdrawalEvent готовит запись журнала тендера об отзыве или повторной подаче предложения.
+// Как и в событии потока, причина по запечатанному тендеру до вскрытия не записывается.
+func bidWithdrawalEvent(bid *models.Bid, tender *models.Tender, user *models.User, action models.AuditAction, details map[string]any) *models.AuditEvent {
+	if tender.Sealed && tender.OpenedAt == nil || details["reason"] == "" {
+		delete(details, "reason")
+	}
+	details["version"] = bid.Version
+	raw, _ := json.Marshal(details)
+	return &models.AuditEvent{
+		TenderID:   tender.ID,
+		EntityType: "bid",
+		EntityID:   bid.ID,
+		Action:     action,
+		ActorID:    user.ID,
+		Details:    raw,
+	}
+}
+
+// checkDecisionPending запрещает отзыв и повторную подачу после окончательного решения
+// по предложению или по одному из его лотов
+func checkDecisionPending(ctx context.Context, bid *models.Bid) {
+	final := bid.Status == models.Closed || bid.Сoordination != models.Expectation
+	for _, bidLot := range getBidLots(ctx, bid.ID) {
+		final = final || bidLot.Сoordination != models.Expectation
+	}
+	if final {
+		fail(http.StatusForbidden, "По предложению уже принято окончательное решение")
+	}
+}
+
+// getOpenBidWithdrawal возвращает действующий отзыв предложения или nil, если предложение не отозвано
+func getOpenBidWithdrawal(ctx context.Context, bidID string) *models.BidWithdrawal {
+	withdrawal, err := database.GetOpenBidWithdrawal(ctx, bidID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		fail(http.StatusInternalServerError, "Ошибка при получении отзыва предложения")
+	}
+	return withdrawal
+}
+
+func createBidWithdrawalResponse(withdrawal *models.BidWithdrawal) models.BidWithdrawalResponse {
+	return models.BidWithdrawalResponse{
+		ID:             withdrawal.ID,
+		BidID:          withdrawal.BidID,
+		Version:        withdrawal.Version,
+		Reason:         withdrawal.Reason,
+		WithdrawnAt:    withdrawal.WithdrawnAt.Format(time.RFC3339),
+		ResubmitReason: withdrawal.ResubmitReason,
+		ResubmittedAt:  models.FormatTime(withdrawal.ResubmittedAt),
+		DecisionsReset: withdrawal.DecisionsReset,
+	}
+}
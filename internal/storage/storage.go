@@ -0,0 +1,57 @@
+// Package storage хранит содержимое вложений тендеров и предложений.
+// Метаданные вложений лежат в Postgres, здесь — только сами файлы, адресуемые ключом.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNotFound возвращается Open, если файла с таким ключом нет
+var ErrNotFound = errors.New("файл не найден в хранилище")
+
+// Storage — хранилище файлов. Ключи состоят из сегментов, разделённых "/".
+type Storage interface {
+	// Put сохраняет содержимое r под ключом key, заменяя прежнее
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Open открывает файл для чтения; Seek нужен для ответов на запросы с Range
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete удаляет файл; отсутствие файла ошибкой не считается
+	Delete(ctx context.Context, key string) error
+	// Ping проверяет доступность хранилища для /readyz
+	Ping(ctx context.Context) error
+}
+
+type Config struct {
+	Backend string // local или s3
+	Dir     string // каталог для local
+	S3      S3Config
+}
+
+// New создаёт хранилище, выбранное в cfg.Backend
+func New(ctx context.Context, cfg Config) (Storage, error) {
+	switch strings.ToLower(cfg.Backend) {
+	case "", "local":
+		return NewLocal(cfg.Dir)
+	case "s3":
+		return NewS3(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("неизвестное хранилище вложений %q", cfg.Backend)
+	}
+}
+
+// validKey отсекает ключи, которые могли бы выйти за пределы каталога или бакета
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." || strings.Contains(segment, `\`) {
+			return false
+		}
+	}
+	return true
+}
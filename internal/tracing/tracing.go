@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "tender-service"
+
+type Config struct {
+	Exporter    string // none, otlp или stdout
+	Protocol    string // grpc или http/protobuf, только для otlp
+	ServiceName string
+}
+
+// Setup настраивает глобальный TracerProvider и распространение W3C trace-context.
+// Адрес, заголовки и TLS OTLP-экспортёра берутся из стандартных переменных OTEL_EXPORTER_OTLP_*.
+// Возвращённую функцию нужно вызвать при остановке сервиса, чтобы отправить накопленные спаны.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = instrumentationName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при создании ресурса трассировки: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(cfg.Exporter) {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	case "otlp":
+		switch strings.ToLower(cfg.Protocol) {
+		case "", "grpc":
+			return otlptracegrpc.New(ctx)
+		case "http/protobuf", "http":
+			return otlptracehttp.New(ctx)
+		default:
+			return nil, fmt.Errorf("некорректный протокол OTLP %q, допустимые значения: grpc, http/protobuf", cfg.Protocol)
+		}
+	default:
+		return nil, fmt.Errorf("некорректный экспортёр трассировки %q, допустимые значения: none, otlp, stdout", cfg.Exporter)
+	}
+}
+
+// Tracer возвращает трассировщик сервиса из глобального провайдера
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
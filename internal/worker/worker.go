@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"tender-service/internal/health"
+	"tender-service/internal/logger"
+	"time"
+)
+
+// Group запускает периодические фоновые задачи и позволяет дождаться их завершения при остановке сервиса
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewGroup(ctx context.Context) *Group {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{ctx: ctx, cancel: cancel}
+}
+
+// Every выполняет fn с заданным интервалом, пока группа не остановлена.
+// Результат каждого запуска передаётся в проверку готовности /readyz, а ошибки пишутся в лог.
+func (g *Group) Every(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	beat := health.RegisterWorker(name, 3*interval)
+	log := logger.FromContext(g.ctx).With(slog.String("worker", name))
+	ctx := logger.WithLogger(g.ctx, log)
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := run(ctx, log, fn)
+				if err != nil && ctx.Err() == nil {
+					log.Error("worker run failed", "error", err)
+				}
+				beat.Beat(err)
+			}
+		}
+	}()
+}
+
+// run выполняет fn и превращает панику в ошибку; стек паники попадает только в лог
+func run(ctx context.Context, log *slog.Logger, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Error("worker panicked", "panic", rec, "stack", string(debug.Stack()))
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+	return fn(ctx)
+}
+
+// Stop отменяет контекст задач и ждёт завершения текущих запусков, но не дольше ctx
+func (g *Group) Stop(ctx context.Context) error {
+	g.cancel()
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("фоновые задачи не завершились вовремя: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+)
+
+// Upload — файл для загрузки; Content читается потоком и передаётся без буферизации
+type Upload struct {
+	Name        string
+	ContentType string // по умолчанию application/octet-stream
+	Content     io.Reader
+}
+
+func (c *Client) UploadTenderAttachment(ctx context.Context, tenderID, username string, file Upload) (*Attachment, error) {
+	return c.upload(ctx, pathEscape("tenders", tenderID, "attachments"), username, file)
+}
+
+// ListTenderAttachments возвращает файлы тендера; username нужен, если тендер не опубликован
+func (c *Client) ListTenderAttachments(ctx context.Context, tenderID, username string) ([]Attachment, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var attachments []Attachment
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "attachments"), query: query, idempotent: true}, &attachments)
+	return attachments, err
+}
+
+// DownloadTenderAttachment открывает содержимое файла тендера. Вызывающий обязан закрыть его.
+func (c *Client) DownloadTenderAttachment(ctx context.Context, tenderID, attachmentID, username string) (io.ReadCloser, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	return c.download(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "attachments", attachmentID), query: query})
+}
+
+func (c *Client) DeleteTenderAttachment(ctx context.Context, tenderID, attachmentID, username string) error {
+	query := url.Values{"username": {username}}
+	return c.do(ctx, request{method: http.MethodDelete, path: pathEscape("tenders", tenderID, "attachments", attachmentID), query: query}, nil)
+}
+
+func (c *Client) UploadBidAttachment(ctx context.Context, bidID, username string, file Upload) (*Attachment, error) {
+	return c.upload(ctx, pathEscape("bids", bidID, "attachments"), username, file)
+}
+
+func (c *Client) ListBidAttachments(ctx context.Context, bidID, username string) ([]Attachment, error) {
+	query := url.Values{"username": {username}}
+	var attachments []Attachment
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "attachments"), query: query, idempotent: true}, &attachments)
+	return attachments, err
+}
+
+// DownloadBidAttachment открывает содержимое файла предложения. Вызывающий обязан закрыть его.
+func (c *Client) DownloadBidAttachment(ctx context.Context, bidID, attachmentID, username string) (io.ReadCloser, error) {
+	query := url.Values{"username": {username}}
+	return c.download(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "attachments", attachmentID), query: query})
+}
+
+func (c *Client) DeleteBidAttachment(ctx context.Context, bidID, attachmentID, username string) error {
+	query := url.Values{"username": {username}}
+	return c.do(ctx, request{method: http.MethodDelete, path: pathEscape("bids", bidID, "attachments", attachmentID), query: query}, nil)
+}
+
+// upload передаёт файл потоком в поле file тела multipart/form-data.
+// Содержимое нельзя прочитать повторно, поэтому запрос не повторяется.
+func (c *Client) upload(ctx context.Context, path, username string, file Upload) (*Attachment, error) {
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	body, pipe := io.Pipe()
+	form := multipart.NewWriter(pipe)
+	go func() {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": file.Name}))
+		header.Set("Content-Type", contentType)
+		part, err := form.CreatePart(header)
+		if err == nil {
+			_, err = io.Copy(part, file.Content)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		pipe.CloseWithError(err)
+	}()
+
+	req := request{
+		method: http.MethodPost,
+		path:   path,
+		query:  url.Values{"username": {username}},
+		header: http.Header{"Content-Type": {form.FormDataContentType()}},
+	}
+	resp, err := c.send(ctx, req, body)
+	// Если сервер ответил, не дочитав тело, горутина не должна остаться заблокированной на записи
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var attachment Attachment
+	if err := c.decode(resp, &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// download возвращает тело успешного ответа без чтения в память
+func (c *Client) download(ctx context.Context, req request) (io.ReadCloser, error) {
+	req.header = http.Header{"Accept": {"*/*"}}
+	resp, err := c.send(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, c.decode(resp, nil)
+	}
+	return resp.Body, nil
+}
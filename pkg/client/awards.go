@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// ListTenderAwards возвращает одну страницу договоров по тендеру
+func (c *Client) ListTenderAwards(ctx context.Context, tenderID, username string, page Page) ([]Award, error) {
+	query := url.Values{"username": {username}}
+	var awards []Award
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "awards"), query: page.apply(query), idempotent: true}, &awards)
+	return awards, err
+}
+
+// TenderAwards обходит все договоры по тендеру
+func (c *Client) TenderAwards(ctx context.Context, tenderID, username string) iter.Seq2[Award, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Award, error) {
+		return c.ListTenderAwards(ctx, tenderID, username, page)
+	})
+}
+
+func (c *Client) GetAward(ctx context.Context, awardID, username string) (*Award, error) {
+	query := url.Values{"username": {username}}
+	var award Award
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("awards", awardID), query: query, idempotent: true}, &award)
+	if err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// UpdateAwardStatus подписывает (Active), завершает (Completed) или расторгает (Terminated) договор
+func (c *Client) UpdateAwardStatus(ctx context.Context, awardID string, status AwardStatus, username string, req AwardStatusRequest) (*Award, error) {
+	query := url.Values{"status": {string(status)}, "username": {username}}
+	var award Award
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("awards", awardID, "status"), query: query, body: req}, &award)
+	if err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// AddAwardMilestone добавляет этап исполнения договора
+func (c *Client) AddAwardMilestone(ctx context.Context, awardID, username string, req AwardMilestoneRequest) (*Award, error) {
+	query := url.Values{"username": {username}}
+	var award Award
+	err := c.do(ctx, request{method: http.MethodPost, path: pathEscape("awards", awardID, "milestones"), query: query, body: req}, &award)
+	if err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// CompleteAwardMilestone отмечает этап договора выполненным
+func (c *Client) CompleteAwardMilestone(ctx context.Context, awardID, milestoneID, username string) (*Award, error) {
+	query := url.Values{"username": {username}}
+	var award Award
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("awards", awardID, "milestones", milestoneID, "complete"), query: query}, &award)
+	if err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func (c *Client) CreateBid(ctx context.Context, req CreateBidRequest) (*Bid, error) {
+	var bid Bid
+	if err := c.do(ctx, withIdempotencyKey(ctx, request{method: http.MethodPost, path: "/bids/new", body: req}), &bid); err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+func (c *Client) ListUserBids(ctx context.Context, username string, page Page) ([]Bid, error) {
+	query := page.apply(url.Values{"username": {username}})
+	var bids []Bid
+	err := c.do(ctx, request{method: http.MethodGet, path: "/bids/my", query: query, idempotent: true}, &bids)
+	return bids, err
+}
+
+// UserBids обходит все предложения пользователя
+func (c *Client) UserBids(ctx context.Context, username string) iter.Seq2[Bid, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Bid, error) {
+		return c.ListUserBids(ctx, username, page)
+	})
+}
+
+func (c *Client) ListTenderBids(ctx context.Context, tenderID, username string, page Page) ([]Bid, error) {
+	query := page.apply(url.Values{"username": {username}})
+	var bids []Bid
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", tenderID, "list"), query: query, idempotent: true}, &bids)
+	return bids, err
+}
+
+// TenderBids обходит все предложения по тендеру; доступно ответственным за организацию тендера
+func (c *Client) TenderBids(ctx context.Context, tenderID, username string) iter.Seq2[Bid, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Bid, error) {
+		return c.ListTenderBids(ctx, tenderID, username, page)
+	})
+}
+
+func (c *Client) GetBidStatus(ctx context.Context, bidID, username string) (BidStatus, error) {
+	query := url.Values{"username": {username}}
+	var status BidStatus
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "status"), query: query, idempotent: true}, &status)
+	return status, err
+}
+
+func (c *Client) UpdateBidStatus(ctx context.Context, bidID string, status BidStatus, username string) (*Bid, error) {
+	query := url.Values{"status": {string(status)}, "username": {username}}
+	var bid Bid
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "status"), query: query, idempotent: true}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+func (c *Client) SubmitBidDecision(ctx context.Context, bidID string, decision Decision, username string) (*Bid, error) {
+	return c.SubmitDecision(ctx, bidID, username, DecisionRequest{Decision: decision})
+}
+
+// SubmitLotDecision принимает решение по лоту lotID предложения, поданного на тендер с лотами
+func (c *Client) SubmitLotDecision(ctx context.Context, bidID, lotID string, decision Decision, username string) (*Bid, error) {
+	return c.SubmitDecision(ctx, bidID, username, DecisionRequest{Decision: decision, LotID: lotID})
+}
+
+// SubmitDecision принимает решение по предложению; в отличие от SubmitBidDecision позволяет указать лот и комментарий
+func (c *Client) SubmitDecision(ctx context.Context, bidID, username string, req DecisionRequest) (*Bid, error) {
+	query := url.Values{"decision": {string(req.Decision)}, "username": {username}}
+	if req.LotID != "" {
+		query.Set("lotId", req.LotID)
+	}
+	if req.Comment != "" {
+		query.Set("comment", req.Comment)
+	}
+	var bid Bid
+	err := c.do(ctx, withIdempotencyKey(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "submit_decision"), query: query}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// RevokeBidDecision отзывает своё решение по текущей версии предложения, пока оно ожидает решения;
+// lotID нужен для тендера с лотами, если предложение участвует в нескольких
+func (c *Client) RevokeBidDecision(ctx context.Context, bidID, lotID, username string) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	if lotID != "" {
+		query.Set("lotId", lotID)
+	}
+	var bid Bid
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "revoke_decision"), query: query}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// ListBidDecisions возвращает одну страницу истории решений по предложению, от последнего к первому
+func (c *Client) ListBidDecisions(ctx context.Context, bidID, username string, page Page) ([]BidDecision, error) {
+	query := url.Values{"username": {username}}
+	var decisions []BidDecision
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "decisions"), query: page.apply(query), idempotent: true}, &decisions)
+	return decisions, err
+}
+
+// BidDecisions обходит всю историю решений по предложению
+func (c *Client) BidDecisions(ctx context.Context, bidID, username string) iter.Seq2[BidDecision, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]BidDecision, error) {
+		return c.ListBidDecisions(ctx, bidID, username, page)
+	})
+}
+
+func (c *Client) EditBid(ctx context.Context, bidID, username string, req EditBidRequest) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	var bid Bid
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPatch, path: pathEscape("bids", bidID, "edit"), query: query, body: req}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// RollbackBid откатывает предложение к версии version; откат создаёт новую версию
+func (c *Client) RollbackBid(ctx context.Context, bidID string, version int, username string) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	var bid Bid
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "rollback", strconv.Itoa(version)), query: query}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+func (c *Client) SubmitBidFeedback(ctx context.Context, bidID, feedback, username string) (*Bid, error) {
+	return c.SubmitFeedback(ctx, bidID, username, FeedbackRequest{Description: feedback})
+}
+
+// SubmitFeedback отправляет отзыв на предложение с оценками; оценить предложение можно один раз
+func (c *Client) SubmitFeedback(ctx context.Context, bidID, username string, req FeedbackRequest) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	var bid Bid
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "feedback"), query: query, body: req}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// GetReputation возвращает репутацию автора предложений по отзывам с оценками на всех тендерах.
+// Для AuthorOrganization authorID — ID организации.
+func (c *Client) GetReputation(ctx context.Context, authorType AuthorType, authorID, username string) (*Reputation, error) {
+	query := url.Values{"authorType": {string(authorType)}, "authorId": {authorID}, "username": {username}}
+	var reputation Reputation
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", "reputation"), query: query, idempotent: true}, &reputation)
+	if err != nil {
+		return nil, err
+	}
+	return &reputation, nil
+}
+
+// SubmitBidScores оценивает предложение по всем критериям тендера; повторный вызов заменяет прежние оценки
+func (c *Client) SubmitBidScores(ctx context.Context, bidID, username string, scores []BidScore) (*BidEvaluation, error) {
+	query := url.Values{"username": {username}}
+	body := struct {
+		Scores []BidScore `json:"scores"`
+	}{scores}
+	var evaluation BidEvaluation
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "scores"), query: query, body: body, idempotent: true}, &evaluation)
+	if err != nil {
+		return nil, err
+	}
+	return &evaluation, nil
+}
+
+// WithdrawBid отзывает опубликованное предложение, пока по нему не принято окончательное решение
+func (c *Client) WithdrawBid(ctx context.Context, bidID, username, reason string) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	body := struct {
+		Reason string `json:"reason"`
+	}{reason}
+	var bid Bid
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "withdraw"), query: query, body: body}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// ResubmitBid снова публикует отозванное предложение; reason может быть пустой.
+// Если предложение меняли после отзыва, прежние решения по нему аннулируются.
+func (c *Client) ResubmitBid(ctx context.Context, bidID, username, reason string) (*Bid, error) {
+	query := url.Values{"username": {username}}
+	body := struct {
+		Reason string `json:"reason,omitempty"`
+	}{reason}
+	var bid Bid
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "resubmit"), query: query, body: body}), &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// ListBidWithdrawals возвращает одну страницу истории отзывов предложения, от последнего к первому
+func (c *Client) ListBidWithdrawals(ctx context.Context, bidID, username string, page Page) ([]BidWithdrawal, error) {
+	query := url.Values{"username": {username}}
+	var withdrawals []BidWithdrawal
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "withdrawals"), query: page.apply(query), idempotent: true}, &withdrawals)
+	return withdrawals, err
+}
+
+// BidWithdrawals обходит всю историю отзывов предложения
+func (c *Client) BidWithdrawals(ctx context.Context, bidID, username string) iter.Seq2[BidWithdrawal, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]BidWithdrawal, error) {
+		return c.ListBidWithdrawals(ctx, bidID, username, page)
+	})
+}
+
+// SubmitAuctionPrice снижает цену предложения на идущих торгах
+func (c *Client) SubmitAuctionPrice(ctx context.Context, bidID, username string, price float64) (*AuctionPosition, error) {
+	query := url.Values{"username": {username}}
+	body := struct {
+		Price float64 `json:"price"`
+	}{price}
+	var position AuctionPosition
+	err := c.do(ctx, withIdempotencyKey(ctx, request{method: http.MethodPut, path: pathEscape("bids", bidID, "price"), query: query, body: body}), &position)
+	if err != nil {
+		return nil, err
+	}
+	return &position, nil
+}
+
+// GetAuctionPosition возвращает место предложения на торгах
+func (c *Client) GetAuctionPosition(ctx context.Context, bidID, username string) (*AuctionPosition, error) {
+	query := url.Values{"username": {username}}
+	var position AuctionPosition
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "auction"), query: query, idempotent: true}, &position)
+	if err != nil {
+		return nil, err
+	}
+	return &position, nil
+}
+
+// ListBidReviews возвращает отзывы на предложения автора authorUsername по тендеру,
+// запрос выполняется от имени ответственного requesterUsername
+func (c *Client) ListBidReviews(ctx context.Context, tenderID, authorUsername, requesterUsername string, page Page) ([]BidReview, error) {
+	query := page.apply(url.Values{"authorUsername": {authorUsername}, "requesterUsername": {requesterUsername}})
+	var reviews []BidReview
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", tenderID, "reviews"), query: query, idempotent: true}, &reviews)
+	return reviews, err
+}
+
+func (c *Client) BidReviews(ctx context.Context, tenderID, authorUsername, requesterUsername string) iter.Seq2[BidReview, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]BidReview, error) {
+		return c.ListBidReviews(ctx, tenderID, authorUsername, requesterUsername, page)
+	})
+}
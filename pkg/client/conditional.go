@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+type ifMatchCtx struct{}
+
+// WithIfMatch задаёт версию, на основе которой выполняется изменение: UpdateTenderStatus, EditTender,
+// RollbackTender и аналогичные методы предложений передадут её в If-Match. Если тендер или предложение
+// успели изменить, метод вернёт ошибку ErrPreconditionFailed.
+func WithIfMatch(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, ifMatchCtx{}, version)
+}
+
+func withIfMatch(ctx context.Context, req request) request {
+	version, ok := ctx.Value(ifMatchCtx{}).(int)
+	if !ok {
+		return req
+	}
+	if req.header == nil {
+		req.header = http.Header{}
+	}
+	req.header.Set("If-Match", strconv.Quote(strconv.Itoa(version)))
+	return req
+}
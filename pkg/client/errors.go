@@ -0,0 +1,54 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrBadRequest         = errors.New("некорректный запрос")
+	ErrUnauthorized       = errors.New("пользователь не существует или некорректен")
+	ErrForbidden          = errors.New("недостаточно прав")
+	ErrNotFound           = errors.New("не найдено")
+	ErrConflict           = errors.New("конфликт")
+	ErrPreconditionFailed = errors.New("версия устарела")
+	ErrServer             = errors.New("ошибка сервера")
+)
+
+// APIError — ошибка, возвращённая сервисом в формате {"reason": "..."}
+type APIError struct {
+	StatusCode int
+	Reason     string
+	RequestID  string
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%d %s: %s (request id %s)", e.StatusCode, http.StatusText(e.StatusCode), e.Reason, e.RequestID)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Reason)
+}
+
+// Is позволяет сравнивать ошибку с ErrNotFound и другими категориями через errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey задаёт ключ идемпотентности для CreateTender, CreateBid, SubmitBidDecision и SubmitAuctionPrice.
+// Без него клиент генерирует случайный ключ на каждый вызов: повторы внутри вызова безопасны,
+// а повтор вызова целиком после сбоя требует передать тот же ключ явно.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// withIdempotencyKey добавляет к запросу заголовок Idempotency-Key; с ним сервер
+// выполняет запрос не более одного раза, поэтому его можно повторять
+func withIdempotencyKey(ctx context.Context, req request) request {
+	key, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	if key == "" {
+		key = uuid.NewString()
+	}
+	if req.header == nil {
+		req.header = http.Header{}
+	}
+	req.header.Set("Idempotency-Key", key)
+	req.idempotent = true
+	return req
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// InviteToTender приглашает пользователя или организацию к тендеру только по приглашениям
+func (c *Client) InviteToTender(ctx context.Context, tenderID, username string, req TenderInvitationRequest) (*TenderInvitation, error) {
+	query := url.Values{"username": {username}}
+	var invitation TenderInvitation
+	err := c.do(ctx, request{method: http.MethodPost, path: pathEscape("tenders", tenderID, "invitations"), query: query, body: req}, &invitation)
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// ListTenderInvitations возвращает одну страницу приглашений к тендеру
+func (c *Client) ListTenderInvitations(ctx context.Context, tenderID, username string, page Page) ([]TenderInvitation, error) {
+	query := url.Values{"username": {username}}
+	var invitations []TenderInvitation
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "invitations"), query: page.apply(query), idempotent: true}, &invitations)
+	return invitations, err
+}
+
+// TenderInvitations обходит все приглашения к тендеру
+func (c *Client) TenderInvitations(ctx context.Context, tenderID, username string) iter.Seq2[TenderInvitation, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]TenderInvitation, error) {
+		return c.ListTenderInvitations(ctx, tenderID, username, page)
+	})
+}
+
+func (c *Client) RevokeTenderInvitation(ctx context.Context, tenderID, invitationID, username string) error {
+	query := url.Values{"username": {username}}
+	return c.do(ctx, request{method: http.MethodDelete, path: pathEscape("tenders", tenderID, "invitations", invitationID), query: query}, nil)
+}
+
+// ListUserInvitations возвращает одну страницу приглашений пользователю и его организациям
+func (c *Client) ListUserInvitations(ctx context.Context, username string, page Page) ([]TenderInvitation, error) {
+	query := url.Values{"username": {username}}
+	var invitations []TenderInvitation
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("invitations", "my"), query: page.apply(query), idempotent: true}, &invitations)
+	return invitations, err
+}
+
+// UserInvitations обходит все приглашения пользователю и его организациям
+func (c *Client) UserInvitations(ctx context.Context, username string) iter.Seq2[TenderInvitation, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]TenderInvitation, error) {
+		return c.ListUserInvitations(ctx, username, page)
+	})
+}
+
+func (c *Client) AcceptInvitation(ctx context.Context, invitationID, username string) (*TenderInvitation, error) {
+	return c.respondToInvitation(ctx, invitationID, username, "accept")
+}
+
+func (c *Client) DeclineInvitation(ctx context.Context, invitationID, username string) (*TenderInvitation, error) {
+	return c.respondToInvitation(ctx, invitationID, username, "decline")
+}
+
+func (c *Client) respondToInvitation(ctx context.Context, invitationID, username, action string) (*TenderInvitation, error) {
+	query := url.Values{"username": {username}}
+	var invitation TenderInvitation
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("invitations", invitationID, action), query: query}, &invitation)
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
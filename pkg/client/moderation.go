@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// ListBidFeedback возвращает одну страницу отзывов на предложение вместе с ответами автора
+func (c *Client) ListBidFeedback(ctx context.Context, bidID, username string, page Page) ([]BidReview, error) {
+	query := url.Values{"username": {username}}
+	var reviews []BidReview
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("bids", bidID, "feedback"), query: page.apply(query), idempotent: true}, &reviews)
+	return reviews, err
+}
+
+// BidFeedback обходит все отзывы на предложение
+func (c *Client) BidFeedback(ctx context.Context, bidID, username string) iter.Seq2[BidReview, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]BidReview, error) {
+		return c.ListBidFeedback(ctx, bidID, username, page)
+	})
+}
+
+// ReplyToFeedback добавляет ответ автора предложения на отзыв
+func (c *Client) ReplyToFeedback(ctx context.Context, feedbackID, username, text string) (*FeedbackReply, error) {
+	query := url.Values{"username": {username}}
+	body := struct {
+		Text string `json:"text"`
+	}{text}
+	var reply FeedbackReply
+	err := c.do(ctx, request{method: http.MethodPost, path: pathEscape("feedback", feedbackID, "replies"), query: query, body: body}, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// ReportFeedback жалуется на отзыв или, если replyID не пуст, на ответ к нему
+func (c *Client) ReportFeedback(ctx context.Context, feedbackID, replyID, username, reason string) (*FeedbackReport, error) {
+	var report FeedbackReport
+	err := c.do(ctx, moderationRequest(feedbackID, replyID, username, "report", reason), &report)
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// HideFeedback скрывает отзыв или ответ на него; причина обязательна
+func (c *Client) HideFeedback(ctx context.Context, feedbackID, replyID, username, reason string) (*ModerationItem, error) {
+	var item ModerationItem
+	err := c.do(ctx, moderationRequest(feedbackID, replyID, username, "hide", reason), &item)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// RestoreFeedback возвращает скрытый отзыв или ответ на него
+func (c *Client) RestoreFeedback(ctx context.Context, feedbackID, replyID, username, reason string) (*ModerationItem, error) {
+	var item ModerationItem
+	err := c.do(ctx, moderationRequest(feedbackID, replyID, username, "restore", reason), &item)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListModerationQueue возвращает одну страницу отзывов и ответов с открытыми жалобами
+func (c *Client) ListModerationQueue(ctx context.Context, username string, page Page) ([]ModerationItem, error) {
+	query := url.Values{"username": {username}}
+	var items []ModerationItem
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("moderation", "feedback"), query: page.apply(query), idempotent: true}, &items)
+	return items, err
+}
+
+// ModerationQueue обходит всю очередь жалоб
+func (c *Client) ModerationQueue(ctx context.Context, username string) iter.Seq2[ModerationItem, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]ModerationItem, error) {
+		return c.ListModerationQueue(ctx, username, page)
+	})
+}
+
+func moderationRequest(feedbackID, replyID, username, action, reason string) request {
+	query := url.Values{"username": {username}}
+	if replyID != "" {
+		query.Set("replyId", replyID)
+	}
+	body := struct {
+		Reason string `json:"reason"`
+	}{reason}
+	return request{method: http.MethodPut, path: pathEscape("feedback", feedbackID, action), query: query, body: body}
+}
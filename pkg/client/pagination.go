@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// maxPageSize — максимальный limit, который принимает API
+const maxPageSize = 50
+
+func (p Page) apply(query url.Values) url.Values {
+	if query == nil {
+		query = url.Values{}
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+	return query
+}
+
+// paginate обходит все страницы списка, пока сервер не вернёт неполную страницу.
+// Итерация прекращается на первой ошибке, которая отдаётся последним элементом.
+func paginate[T any](ctx context.Context, fetch func(ctx context.Context, page Page) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := Page{Limit: maxPageSize}
+		for {
+			items, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) < page.Limit {
+				return
+			}
+			page.Offset += len(items)
+		}
+	}
+}
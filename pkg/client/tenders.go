@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Ping проверяет доступность сервиса
+func (c *Client) Ping(ctx context.Context) error {
+	var body string
+	return c.do(ctx, request{method: http.MethodGet, path: "/ping", idempotent: true}, &body)
+}
+
+func (c *Client) CreateTender(ctx context.Context, req CreateTenderRequest) (*Tender, error) {
+	var tender Tender
+	err := c.do(ctx, withIdempotencyKey(ctx, request{method: http.MethodPost, path: "/tenders/new", body: req}), &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+type ListTendersParams struct {
+	ServiceTypes []ServiceType
+	Page
+}
+
+// ListTenders возвращает одну страницу опубликованных тендеров, отсортированных по названию
+func (c *Client) ListTenders(ctx context.Context, params ListTendersParams) ([]Tender, error) {
+	query := url.Values{}
+	for _, serviceType := range params.ServiceTypes {
+		query.Add("service_type", string(serviceType))
+	}
+	var tenders []Tender
+	err := c.do(ctx, request{method: http.MethodGet, path: "/tenders", query: params.Page.apply(query), idempotent: true}, &tenders)
+	return tenders, err
+}
+
+// Tenders обходит все тендеры с указанными типами услуг
+func (c *Client) Tenders(ctx context.Context, serviceTypes ...ServiceType) iter.Seq2[Tender, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Tender, error) {
+		return c.ListTenders(ctx, ListTendersParams{ServiceTypes: serviceTypes, Page: page})
+	})
+}
+
+func (c *Client) ListUserTenders(ctx context.Context, username string, page Page) ([]Tender, error) {
+	query := page.apply(url.Values{"username": {username}})
+	var tenders []Tender
+	err := c.do(ctx, request{method: http.MethodGet, path: "/tenders/my", query: query, idempotent: true}, &tenders)
+	return tenders, err
+}
+
+// UserTenders обходит все тендеры, созданные пользователем
+func (c *Client) UserTenders(ctx context.Context, username string) iter.Seq2[Tender, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Tender, error) {
+		return c.ListUserTenders(ctx, username, page)
+	})
+}
+
+func (c *Client) GetTenderStatus(ctx context.Context, tenderID, username string) (TenderStatus, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var status TenderStatus
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "status"), query: query, idempotent: true}, &status)
+	return status, err
+}
+
+func (c *Client) UpdateTenderStatus(ctx context.Context, tenderID string, status TenderStatus, username string) (*Tender, error) {
+	query := url.Values{"status": {string(status)}, "username": {username}}
+	var tender Tender
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("tenders", tenderID, "status"), query: query, idempotent: true}), &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+func (c *Client) EditTender(ctx context.Context, tenderID, username string, req EditTenderRequest) (*Tender, error) {
+	query := url.Values{"username": {username}}
+	var tender Tender
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPatch, path: pathEscape("tenders", tenderID, "edit"), query: query, body: req}), &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+// RollbackTender откатывает тендер к версии version; откат создаёт новую версию
+func (c *Client) RollbackTender(ctx context.Context, tenderID string, version int, username string) (*Tender, error) {
+	query := url.Values{"username": {username}}
+	var tender Tender
+	err := c.do(ctx, withIfMatch(ctx, request{method: http.MethodPut, path: pathEscape("tenders", tenderID, "rollback", strconv.Itoa(version)), query: query}), &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+// ListTenderLots возвращает лоты тендера; username нужен, если тендер не опубликован
+func (c *Client) ListTenderLots(ctx context.Context, tenderID, username string) ([]Lot, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var lots []Lot
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "lots"), query: query, idempotent: true}, &lots)
+	return lots, err
+}
+
+// AskClarification задаёт вопрос по опубликованному тендеру
+func (c *Client) AskClarification(ctx context.Context, tenderID, username, question string) (*Clarification, error) {
+	query := url.Values{"username": {username}}
+	body := map[string]string{"question": question}
+	var clarification Clarification
+	err := c.do(ctx, request{method: http.MethodPost, path: pathEscape("tenders", tenderID, "clarifications"), query: query, body: body}, &clarification)
+	if err != nil {
+		return nil, err
+	}
+	return &clarification, nil
+}
+
+// ListClarifications возвращает одну страницу вопросов по тендеру; username нужен, если тендер не опубликован
+func (c *Client) ListClarifications(ctx context.Context, tenderID, username string, page Page) ([]Clarification, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var clarifications []Clarification
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "clarifications"), query: page.apply(query), idempotent: true}, &clarifications)
+	return clarifications, err
+}
+
+// Clarifications обходит все вопросы по тендеру, доступные пользователю
+func (c *Client) Clarifications(ctx context.Context, tenderID, username string) iter.Seq2[Clarification, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Clarification, error) {
+		return c.ListClarifications(ctx, tenderID, username, page)
+	})
+}
+
+// AnswerClarification отвечает на вопрос; повторный ответ заменяет прежний
+func (c *Client) AnswerClarification(ctx context.Context, tenderID, clarificationID, username, answer string) (*Clarification, error) {
+	query := url.Values{"username": {username}}
+	body := map[string]string{"answer": answer}
+	var clarification Clarification
+	path := pathEscape("tenders", tenderID, "clarifications", clarificationID, "answer")
+	err := c.do(ctx, request{method: http.MethodPut, path: path, query: query, body: body, idempotent: true}, &clarification)
+	if err != nil {
+		return nil, err
+	}
+	return &clarification, nil
+}
+
+// SetEvaluationCriteria заменяет критерии оценки тендера; это возможно, пока ни одно предложение не оценено
+func (c *Client) SetEvaluationCriteria(ctx context.Context, tenderID, username string, criteria []Criterion) ([]Criterion, error) {
+	query := url.Values{"username": {username}}
+	var saved []Criterion
+	err := c.do(ctx, request{method: http.MethodPut, path: pathEscape("tenders", tenderID, "criteria"), query: query, body: criteria, idempotent: true}, &saved)
+	return saved, err
+}
+
+// ListEvaluationCriteria возвращает критерии оценки тендера; username нужен, если тендер не опубликован
+func (c *Client) ListEvaluationCriteria(ctx context.Context, tenderID, username string) ([]Criterion, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var criteria []Criterion
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "criteria"), query: query, idempotent: true}, &criteria)
+	return criteria, err
+}
+
+// GetBidRanking возвращает предложения по тендеру, упорядоченные по взвешенной оценке
+func (c *Client) GetBidRanking(ctx context.Context, tenderID, username string) ([]RankingEntry, error) {
+	query := url.Values{"username": {username}}
+	var ranking []RankingEntry
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "ranking"), query: query, idempotent: true}, &ranking)
+	return ranking, err
+}
+
+// ListAuditEvents возвращает одну страницу журнала событий тендера
+func (c *Client) ListAuditEvents(ctx context.Context, tenderID, username string, page Page) ([]AuditEvent, error) {
+	query := url.Values{"username": {username}}
+	var events []AuditEvent
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "audit"), query: page.apply(query), idempotent: true}, &events)
+	return events, err
+}
+
+// AuditEvents обходит весь журнал событий тендера
+func (c *Client) AuditEvents(ctx context.Context, tenderID, username string) iter.Seq2[AuditEvent, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]AuditEvent, error) {
+		return c.ListAuditEvents(ctx, tenderID, username, page)
+	})
+}
+
+// GetAuction возвращает торги по тендеру; username необязателен для опубликованного тендера
+func (c *Client) GetAuction(ctx context.Context, tenderID, username string) (*Auction, error) {
+	query := url.Values{}
+	if username != "" {
+		query.Set("username", username)
+	}
+	var auction Auction
+	err := c.do(ctx, request{method: http.MethodGet, path: pathEscape("tenders", tenderID, "auction"), query: query, idempotent: true}, &auction)
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+// Spec возвращает спецификацию OpenAPI, с которой работает сервер
+func (c *Client) Spec(ctx context.Context) ([]byte, error) {
+	var spec string
+	err := c.do(ctx, request{method: http.MethodGet, path: "/openapi.yml", idempotent: true}, &spec)
+	return []byte(spec), err
+}
@@ -0,0 +1,459 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ServiceType string
+
+const (
+	Construction ServiceType = "Construction"
+	Delivery     ServiceType = "Delivery"
+	Manufacture  ServiceType = "Manufacture"
+)
+
+type TenderStatus string
+
+const (
+	TenderCreated   TenderStatus = "Created"
+	TenderPublished TenderStatus = "Published"
+	TenderClosed    TenderStatus = "Closed"
+)
+
+// TenderVisibility — кто видит тендер и может подавать на него предложения
+type TenderVisibility string
+
+const (
+	VisibilityPublic     TenderVisibility = "Public"
+	VisibilityInviteOnly TenderVisibility = "InviteOnly"
+)
+
+type BidStatus string
+
+const (
+	BidCreated   BidStatus = "Created"
+	BidPublished BidStatus = "Published"
+	BidCanceled  BidStatus = "Canceled"
+	BidClosed    BidStatus = "Closed"
+)
+
+type AuthorType string
+
+const (
+	AuthorOrganization AuthorType = "Organization"
+	AuthorUser         AuthorType = "User"
+)
+
+type Decision string
+
+const (
+	Approved Decision = "Approved"
+	Rejected Decision = "Rejected"
+)
+
+// Coordination — итог согласования предложения по лоту
+type Coordination string
+
+const (
+	CoordinationExpectation        Coordination = "Expectation"
+	CoordinationApproved           Coordination = "Approved"
+	CoordinationRejected           Coordination = "Rejected"
+	CoordinationRejectedByConflict Coordination = "RejectedByConflict"
+)
+
+type Tender struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	Description    string           `json:"description"`
+	ServiceType    ServiceType      `json:"serviceType"`
+	Status         TenderStatus     `json:"status"`
+	OrganizationID string           `json:"organizationId"`
+	Version        int              `json:"version"`
+	CreatedAt      string           `json:"createdAt"`
+	BidDeadline    string           `json:"bidDeadline,omitempty"` // RFC3339; пусто, если срок не ограничен
+	Sealed         bool             `json:"sealed,omitempty"`
+	OpenedAt       string           `json:"openedAt,omitempty"` // RFC3339; пусто, пока запечатанный тендер не вскрыт
+	Visibility     TenderVisibility `json:"visibility,omitempty"`
+}
+
+type CreateTenderRequest struct {
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	ServiceType     ServiceType      `json:"serviceType"`
+	OrganizationID  string           `json:"organizationId"`
+	CreatorUsername string           `json:"creatorUsername"`
+	Lots            []LotRequest     `json:"lots,omitempty"`
+	BidDeadline     *time.Time       `json:"bidDeadline,omitempty"` // срок подачи предложений и вопросов
+	Sealed          bool             `json:"sealed,omitempty"`      // скрывать содержимое предложений до срока подачи; требует BidDeadline
+	Auction         *AuctionSettings `json:"auction,omitempty"`     // торги на понижение цены; только для тендера без лотов
+	Visibility      TenderVisibility `json:"visibility,omitempty"`  // пусто — открытый тендер
+}
+
+// EditTenderRequest — пустые поля не изменяются
+type EditTenderRequest struct {
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description,omitempty"`
+	ServiceType ServiceType `json:"serviceType,omitempty"`
+	BidDeadline *time.Time  `json:"bidDeadline,omitempty"`
+}
+
+type Bid struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Status      BidStatus  `json:"status"`
+	TenderID    string     `json:"tenderId"`
+	AuthorType  AuthorType `json:"authorType"`
+	AuthorID    string     `json:"authorId"`
+	Version     int        `json:"version"`
+	CreatedAt   string     `json:"createdAt"`
+	Lots        []BidLot   `json:"lots,omitempty"`
+	Sealed      bool       `json:"sealed,omitempty"` // не вскрыто: содержимое видит только автор, остальные — заглушки
+	// AuthorReputation приходит только в списке предложений тендера для ответственных
+	AuthorReputation *Reputation `json:"authorReputation,omitempty"`
+}
+
+type CreateBidRequest struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	TenderID    string          `json:"tenderId"`
+	AuthorType  AuthorType      `json:"authorType"`
+	AuthorID    string          `json:"authorId"`
+	Lots        []BidLotRequest `json:"lots,omitempty"`
+}
+
+// Lot — часть тендера, присуждаемая независимо от остальных
+type Lot struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Quantity     int     `json:"quantity"`
+	Budget       float64 `json:"budget"`
+	AwardedBidID string  `json:"awardedBidId,omitempty"`
+}
+
+type LotRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Quantity    int     `json:"quantity"`
+	Budget      float64 `json:"budget"`
+}
+
+// BidLot — участие предложения в лоте
+type BidLot struct {
+	LotID        string       `json:"lotId"`
+	Price        float64      `json:"price"`
+	Coordination Coordination `json:"coordination"`
+}
+
+type BidLotRequest struct {
+	LotID string  `json:"lotId"`
+	Price float64 `json:"price"`
+}
+
+// Attachment — метаданные файла тендера или предложения
+type Attachment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"` // SHA-256 в шестнадцатеричном виде
+	Version     int    `json:"version"`  // версия тендера или предложения на момент загрузки
+	CreatedAt   string `json:"createdAt"`
+}
+
+// Criterion — критерий оценки предложений; при задании критериев ID не заполняется
+type Criterion struct {
+	ID          string  `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Weight      float64 `json:"weight"` // доля в итоговой оценке, веса нормируются на их сумму
+}
+
+// BidScore — оценка предложения по критерию от 0 до 10
+type BidScore struct {
+	CriterionID string `json:"criterionId"`
+	Score       int    `json:"score"`
+}
+
+type BidEvaluation struct {
+	BidID  string     `json:"bidId"`
+	Scores []BidScore `json:"scores"`
+}
+
+type CriterionAverage struct {
+	CriterionID string  `json:"criterionId"`
+	Average     float64 `json:"average"`
+}
+
+// RankingEntry — строка рейтинга предложений; Rank и Score не заданы, пока предложение никто не оценил
+type RankingEntry struct {
+	Rank         int                `json:"rank,omitempty"`
+	BidID        string             `json:"bidId"`
+	BidName      string             `json:"bidName"`
+	AuthorType   AuthorType         `json:"authorType"`
+	AuthorID     string             `json:"authorId"`
+	Status       BidStatus          `json:"status"`
+	Coordination Coordination       `json:"coordination"`
+	Score        *float64           `json:"score,omitempty"`
+	Evaluators   int                `json:"evaluators"`
+	Criteria     []CriterionAverage `json:"criteria"`
+}
+
+// Clarification — вопрос по тендеру и ответ организации; автор вопроса не раскрывается
+type Clarification struct {
+	ID         string `json:"id"`
+	Question   string `json:"question"`
+	Answer     string `json:"answer,omitempty"` // пусто, пока нет ответа
+	Mine       bool   `json:"mine,omitempty"`   // вопрос задал пользователь из запроса
+	CreatedAt  string `json:"createdAt"`
+	AnsweredAt string `json:"answeredAt,omitempty"`
+}
+
+// AuditEvent — запись журнала событий тендера
+type AuditEvent struct {
+	ID         string          `json:"id"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId"`
+	Action     string          `json:"action"`
+	ActorID    string          `json:"actorId,omitempty"` // пусто, если событие произошло в фоновой задаче
+	Details    json.RawMessage `json:"details,omitempty"`
+	CreatedAt  string          `json:"createdAt"`
+}
+
+// AuctionSettings — расписание торгов на понижение цены
+type AuctionSettings struct {
+	StartsAt         time.Time `json:"startsAt"`
+	EndsAt           time.Time `json:"endsAt"`
+	MinDecrement     float64   `json:"minDecrement"`               // минимальный шаг снижения цены
+	ExtensionSeconds int       `json:"extensionSeconds,omitempty"` // ставка ближе к концу продлевает торги на это время
+}
+
+type AuctionStatus string
+
+const (
+	AuctionScheduled AuctionStatus = "Scheduled"
+	AuctionRunning   AuctionStatus = "Running"
+	AuctionClosed    AuctionStatus = "Closed"
+)
+
+// Auction — состояние торгов; Entries заполняются только для ответственных за организацию тендера
+type Auction struct {
+	TenderID         string         `json:"tenderId"`
+	StartsAt         string         `json:"startsAt"`
+	EndsAt           string         `json:"endsAt"` // с учётом продлений
+	MinDecrement     float64        `json:"minDecrement"`
+	ExtensionSeconds int            `json:"extensionSeconds"`
+	Status           AuctionStatus  `json:"status"`
+	ClosedAt         string         `json:"closedAt,omitempty"`
+	AwardedBidID     string         `json:"awardedBidId,omitempty"`
+	Entries          []AuctionEntry `json:"entries,omitempty"`
+}
+
+type AuctionEntry struct {
+	Rank       int        `json:"rank"`
+	BidID      string     `json:"bidId"`
+	AuthorType AuthorType `json:"authorType"`
+	AuthorID   string     `json:"authorId"`
+	Price      float64    `json:"price"`
+	UpdatedAt  string     `json:"updatedAt"`
+}
+
+// AuctionPosition — место предложения на торгах без сведений о других участниках
+type AuctionPosition struct {
+	BidID        string   `json:"bidId"`
+	Price        *float64 `json:"price,omitempty"` // nil, пока автор не назвал цену
+	Rank         int      `json:"rank,omitempty"`
+	Participants int      `json:"participants"`
+	BestPrice    *float64 `json:"bestPrice,omitempty"`
+	Auction      Auction  `json:"auction"`
+}
+
+// BidWithdrawal — отзыв предложения автором; ResubmittedAt пуст, пока предложение отозвано
+type BidWithdrawal struct {
+	ID             string `json:"id"`
+	BidID          string `json:"bidId"`
+	Version        int    `json:"version"` // версия предложения на момент отзыва
+	Reason         string `json:"reason"`
+	WithdrawnAt    string `json:"withdrawnAt"`
+	ResubmitReason string `json:"resubmitReason,omitempty"`
+	ResubmittedAt  string `json:"resubmittedAt,omitempty"`
+	DecisionsReset bool   `json:"decisionsReset"` // решения до отзыва аннулированы при повторной подаче
+}
+
+// DecisionRequest — решение по предложению; LotID и Comment необязательны
+type DecisionRequest struct {
+	Decision Decision
+	LotID    string
+	Comment  string
+}
+
+// BidDecision — решение в истории решений по предложению. Автору предложения приходят
+// только решения с комментарием, и Author у них пуст.
+type BidDecision struct {
+	ID            string   `json:"id"`
+	BidID         string   `json:"bidId"`
+	LotID         string   `json:"lotId,omitempty"`
+	BidVersion    int      `json:"bidVersion"`
+	Author        string   `json:"author,omitempty"`
+	Decision      Decision `json:"decision"`
+	Comment       string   `json:"comment,omitempty"`
+	CreatedAt     string   `json:"createdAt"`
+	RevokedAt     string   `json:"revokedAt,omitempty"`
+	InvalidatedAt string   `json:"invalidatedAt,omitempty"`
+}
+
+// EditBidRequest — пустые поля не изменяются
+type EditBidRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type BidReview struct {
+	ID          string           `json:"id"`
+	Description string           `json:"description"`
+	Ratings     *FeedbackRatings `json:"ratings,omitempty"` // нет у текстовых отзывов
+	CreatedAt   string           `json:"createdAt"`
+	Replies     []FeedbackReply  `json:"replies,omitempty"` // ответы автора предложения
+}
+
+// FeedbackReply — ответ автора предложения на отзыв
+type FeedbackReply struct {
+	ID         string `json:"id"`
+	FeedbackID string `json:"feedbackId"`
+	Author     string `json:"author"`
+	Text       string `json:"text"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// FeedbackReport — жалоба на отзыв или, если задан ReplyID, на ответ к нему
+type FeedbackReport struct {
+	ID         string `json:"id"`
+	FeedbackID string `json:"feedbackId"`
+	ReplyID    string `json:"replyId,omitempty"`
+	Reason     string `json:"reason"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// ModerationItem — отзыв или ответ на него с открытыми жалобами
+type ModerationItem struct {
+	FeedbackID      string   `json:"feedbackId"`
+	ReplyID         string   `json:"replyId,omitempty"`
+	BidID           string   `json:"bidId"`
+	TenderID        string   `json:"tenderId"`
+	Text            string   `json:"text"`
+	Reports         int      `json:"reports"`
+	Reasons         []string `json:"reasons,omitempty"`
+	FirstReportedAt string   `json:"firstReportedAt,omitempty"`
+	Hidden          bool     `json:"hidden"`
+}
+
+// FeedbackRatings — оценки исполнителя от 1 до 5
+type FeedbackRatings struct {
+	Quality       int `json:"quality"`
+	Timeliness    int `json:"timeliness"`
+	Communication int `json:"communication"`
+}
+
+// FeedbackRequest — отзыв на предложение; Ratings необязательны
+type FeedbackRequest struct {
+	Description string           `json:"description"`
+	Ratings     *FeedbackRatings `json:"ratings,omitempty"`
+}
+
+// Reputation — средние оценки из отзывов на предложения автора; Ratings нет, пока отзывов с оценками нет
+type Reputation struct {
+	AuthorType AuthorType      `json:"authorType"`
+	AuthorID   string          `json:"authorId"`
+	Reviews    int             `json:"reviews"`
+	Tenders    int             `json:"tenders"`
+	Ratings    *RatingAverages `json:"ratings,omitempty"`
+}
+
+// RatingAverages — средние оценки, округлённые до сотых; Overall — среднее по трём показателям
+type RatingAverages struct {
+	Quality       float64 `json:"quality"`
+	Timeliness    float64 `json:"timeliness"`
+	Communication float64 `json:"communication"`
+	Overall       float64 `json:"overall"`
+}
+
+// Page задаёт страницу списка. Нулевой Limit означает значение сервера по умолчанию (5).
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// AwardStatus — состояние договора по присуждению
+type AwardStatus string
+
+const (
+	AwardPendingSignature AwardStatus = "PendingSignature"
+	AwardActive           AwardStatus = "Active"
+	AwardCompleted        AwardStatus = "Completed"
+	AwardTerminated       AwardStatus = "Terminated"
+)
+
+// Award — договор по присуждению тендера или лота; Price нет для тендера без лотов и торгов
+type Award struct {
+	ID           string           `json:"id"`
+	TenderID     string           `json:"tenderId"`
+	LotID        string           `json:"lotId,omitempty"`
+	BidID        string           `json:"bidId"`
+	BidVersion   int              `json:"bidVersion"`
+	Price        *float64         `json:"price,omitempty"`
+	Status       AwardStatus      `json:"status"`
+	StatusReason string           `json:"statusReason,omitempty"`
+	CreatedAt    string           `json:"createdAt"`
+	UpdatedAt    string           `json:"updatedAt"`
+	Milestones   []AwardMilestone `json:"milestones"`
+}
+
+type AwardMilestone struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	DueDate     string `json:"dueDate"`
+	CompletedAt string `json:"completedAt,omitempty"`
+	Overdue     bool   `json:"overdue"`
+}
+
+// AwardStatusRequest — причина смены статуса (обязательна при расторжении) и отзыв о поставщике при завершении
+type AwardStatusRequest struct {
+	Reason   string           `json:"reason,omitempty"`
+	Feedback *FeedbackRequest `json:"feedback,omitempty"`
+}
+
+type AwardMilestoneRequest struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	DueDate     *time.Time `json:"dueDate"`
+}
+
+// InvitationStatus — состояние приглашения к тендеру
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "Pending"
+	InvitationAccepted InvitationStatus = "Accepted"
+	InvitationDeclined InvitationStatus = "Declined"
+)
+
+// TenderInvitation — приглашение к тендеру; задано Username или OrganizationID адресата
+type TenderInvitation struct {
+	ID             string           `json:"id"`
+	TenderID       string           `json:"tenderId"`
+	TenderName     string           `json:"tenderName"`
+	Username       string           `json:"username,omitempty"`
+	OrganizationID string           `json:"organizationId,omitempty"`
+	Status         InvitationStatus `json:"status"`
+	CreatedAt      string           `json:"createdAt"`
+	RespondedAt    string           `json:"respondedAt,omitempty"`
+}
+
+// TenderInvitationRequest — адресат приглашения: ровно одно из полей
+type TenderInvitationRequest struct {
+	Username       string `json:"username,omitempty"`
+	OrganizationID string `json:"organizationId,omitempty"`
+}